@@ -0,0 +1,140 @@
+package api
+
+import (
+	"organization_service/api/docs"
+	"organization_service/api/handler"
+	"organization_service/config"
+	"organization_service/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// SetUpAPI exposes the gRPC services over HTTP/JSON. Every request is
+// forwarded to the gRPC server through conn, so the REST layer gets the same
+// behaviour as gRPC clients do.
+// @title Organization Service API
+// @version 1.0
+// @description REST/JSON gateway of the market organization service.
+// @BasePath /v1
+func SetUpAPI(cfg config.Config, log logger.LoggerI, conn grpc.ClientConnInterface) *gin.Engine {
+	docs.SwaggerInfo.Version = cfg.Version
+
+	r := gin.New()
+
+	// without trusted proxies gin takes the client address from the
+	// connection, never from X-Forwarded-For
+	if err := r.SetTrustedProxies(cfg.HTTPTrustedProxies); err != nil {
+		log.Panic("gin.SetTrustedProxies", logger.Error(err))
+	}
+
+	r.Use(gin.Recovery(), handler.RequestID())
+	if cfg.Environment != config.ReleaseMode {
+		r.Use(gin.Logger())
+	}
+
+	h := handler.NewHandler(cfg, log, conn)
+
+	v1 := r.Group("/v1")
+
+	v1.POST("/filial", h.CreateFilial)
+	v1.GET("/filial/code-preview", h.PreviewFilialCode)
+	v1.GET("/filial/nearest", h.FindNearestFilials)
+	v1.GET("/filial/:id", h.GetFilialByID)
+	v1.GET("/filial", h.GetFilialList)
+	v1.PUT("/filial/:id", h.UpdateFilial)
+	v1.PATCH("/filial/:id", h.UpdatePatchFilial)
+	v1.DELETE("/filial/:id", h.DeleteFilial)
+	v1.PUT("/filial/:id/hours", h.SetFilialWeeklyHours)
+	v1.GET("/filial/:id/hours", h.GetFilialWeeklyHours)
+	v1.PUT("/filial/:id/exceptions/:date", h.SetFilialScheduleException)
+	v1.GET("/filial/:id/exceptions", h.GetFilialScheduleExceptionList)
+
+	v1.POST("/magazin", h.CreateMagazin)
+	v1.GET("/magazin/:id", h.GetMagazinByID)
+	v1.GET("/magazin", h.GetMagazinList)
+	v1.PUT("/magazin/:id", h.UpdateMagazin)
+	v1.PATCH("/magazin/:id", h.UpdatePatchMagazin)
+	v1.DELETE("/magazin/:id", h.DeleteMagazin)
+	v1.GET("/magazin/:id/providers", h.GetMagazinProviderList)
+	v1.PUT("/magazin/:id/hours", h.SetMagazinWeeklyHours)
+	v1.GET("/magazin/:id/hours", h.GetMagazinWeeklyHours)
+	v1.PUT("/magazin/:id/exceptions/:date", h.SetMagazinScheduleException)
+	v1.GET("/magazin/:id/exceptions", h.GetMagazinScheduleExceptionList)
+
+	v1.DELETE("/schedule-exception/:id", h.RemoveScheduleException)
+	v1.GET("/schedule/:id", h.GetSchedule)
+	v1.GET("/schedule/:id/open", h.IsOpen)
+
+	v1.POST("/staff", h.CreateStaff)
+	v1.GET("/staff/by-phone", h.GetStaffByPhone)
+	v1.GET("/staff/:id", h.GetStaffByID)
+	v1.GET("/staff", h.GetStaffList)
+	v1.PUT("/staff/:id", h.UpdateStaff)
+	v1.PATCH("/staff/:id", h.UpdatePatchStaff)
+	v1.DELETE("/staff/:id", h.DeleteStaff)
+	v1.POST("/staff/:id/suspend", h.SuspendStaff)
+	v1.POST("/staff/:id/reactivate", h.ReactivateStaff)
+	v1.POST("/staff/:id/terminate", h.TerminateStaff)
+	v1.POST("/staff/:id/unlock", h.UnlockStaff)
+	v1.POST("/staff/login", h.LoginStaff)
+	v1.POST("/staff/validate-token", h.ValidateStaffToken)
+	v1.POST("/staff/password-reset", h.RequestStaffPasswordReset)
+	v1.POST("/staff/password-reset/confirm", h.ConfirmStaffPasswordReset)
+
+	v1.POST("/provider", h.CreateProvider)
+	v1.GET("/provider/by-phone", h.GetProviderByPhone)
+	v1.GET("/provider/duplicates", h.FindProviderDuplicates)
+	v1.GET("/provider/:id", h.GetProviderByID)
+	v1.GET("/provider", h.GetProviderList)
+	v1.PUT("/provider/:id", h.UpdateProvider)
+	v1.PATCH("/provider/:id", h.UpdatePatchProvider)
+	v1.DELETE("/provider/:id", h.DeleteProvider)
+	v1.POST("/provider/:id/status", h.ChangeProviderStatus)
+	v1.GET("/provider/:id/status-history", h.GetProviderStatusHistory)
+	v1.POST("/provider/:id/contacts", h.AddProviderContact)
+	v1.GET("/provider/:id/contacts", h.GetProviderContactList)
+	v1.PUT("/provider/:id/contacts/:contact_id", h.UpdateProviderContact)
+	v1.DELETE("/provider/:id/contacts/:contact_id", h.RemoveProviderContact)
+	v1.PUT("/provider/:id/magazins/:magazin_id", h.AssignProvider)
+	v1.DELETE("/provider/:id/magazins/:magazin_id", h.UnassignProvider)
+	v1.GET("/provider/:id/magazins", h.GetProviderMagazinList)
+	v1.POST("/provider/:id/products", h.AddProviderProduct)
+	v1.GET("/provider/:id/products", h.GetProviderProductList)
+	v1.PUT("/provider/:id/products/:provider_product_id", h.UpdateProviderProduct)
+	v1.DELETE("/provider/:id/products/:provider_product_id", h.RemoveProviderProduct)
+	v1.POST("/provider/:id/deliveries", h.RecordProviderDelivery)
+	v1.GET("/provider/:id/deliveries", h.GetProviderDeliveryList)
+	v1.POST("/provider/:id/ratings", h.RateProvider)
+	v1.GET("/provider/:id/ratings", h.GetProviderRatingList)
+	v1.GET("/provider/:id/scorecard", h.GetProviderScorecard)
+	v1.POST("/provider/:id/merge", h.MergeProviders)
+	v1.GET("/product/:id/providers", h.GetProductProviderList)
+
+	v1.POST("/purchase-order", h.CreatePurchaseOrder)
+	v1.GET("/purchase-order/:id", h.GetPurchaseOrderByID)
+	v1.GET("/purchase-order", h.GetPurchaseOrderList)
+	v1.PUT("/purchase-order/:id", h.UpdatePurchaseOrder)
+	v1.DELETE("/purchase-order/:id", h.DeletePurchaseOrder)
+	v1.POST("/purchase-order/:id/status", h.ChangePurchaseOrderStatus)
+
+	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	return r
+}
+
+// DialServer opens the connection the gateway uses to reach the gRPC server
+// of this service.
+func DialServer(cfg config.Config) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		cfg.ServiceHost+cfg.ServicePort,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	)
+}
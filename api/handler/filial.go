@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"net/http"
+	"organization_service/genproto/organization_service"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateFilial godoc
+// @ID create_filial
+// @Router /filial [POST]
+// @Summary Create Filial
+// @Description Create Filial
+// @Tags Filial
+// @Accept json
+// @Produce json
+// @Param filial body organization_service.CreateFilial true "CreateFilialRequestBody"
+// @Success 201 {object} organization_service.Filial "Filial data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) CreateFilial(c *gin.Context) {
+	var req organization_service.CreateFilial
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	resp, err := h.filial.Create(h.context(c), &req)
+	h.handleResponse(c, http.StatusCreated, resp, err)
+}
+
+// GetFilialByID godoc
+// @ID get_filial_by_id
+// @Router /filial/{id} [GET]
+// @Summary Get Filial By ID
+// @Description Get Filial By ID
+// @Tags Filial
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} organization_service.Filial "Filial data"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetFilialByID(c *gin.Context) {
+	resp, err := h.filial.GetByID(h.context(c), &organization_service.FilialPK{Id: c.Param("id")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetFilialList godoc
+// @ID get_filial_list
+// @Router /filial [GET]
+// @Summary Get Filials List
+// @Description Get Filials List
+// @Tags Filial
+// @Accept json
+// @Produce json
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param search query string false "search"
+// @Success 200 {object} organization_service.GetListFilialResponse "GetListFilialResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetFilialList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.filial.GetList(h.context(c), &organization_service.GetListFilialRequest{
+		Offset: offset,
+		Limit:  limit,
+		Search: c.Query("search"),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdateFilial godoc
+// @ID update_filial
+// @Router /filial/{id} [PUT]
+// @Summary Update Filial
+// @Description Update Filial
+// @Tags Filial
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param filial body organization_service.UpdateFilial true "UpdateFilialRequestBody"
+// @Success 200 {object} organization_service.Filial "Filial data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdateFilial(c *gin.Context) {
+	var req organization_service.UpdateFilial
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.filial.Update(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdatePatchFilial godoc
+// @ID update_patch_filial
+// @Router /filial/{id} [PATCH]
+// @Summary Update Patch Filial
+// @Description Update Patch Filial
+// @Tags Filial
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param filial body organization_service.UpdatePatchFilial true "UpdatePatchFilialRequestBody"
+// @Success 200 {object} organization_service.Filial "Filial data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdatePatchFilial(c *gin.Context) {
+	var req organization_service.UpdatePatchFilial
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.filial.UpdatePatch(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// DeleteFilial godoc
+// @ID delete_filial
+// @Router /filial/{id} [DELETE]
+// @Summary Delete Filial
+// @Description Delete Filial
+// @Tags Filial
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 204
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) DeleteFilial(c *gin.Context) {
+	_, err := h.filial.Delete(h.context(c), &organization_service.FilialPK{Id: c.Param("id")})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PreviewFilialCode godoc
+// @ID preview_filial_code
+// @Router /filial/code-preview [GET]
+// @Summary Preview Filial Code
+// @Description Preview the code the next filial created in a region gets
+// @Tags Filial
+// @Accept json
+// @Produce json
+// @Param region query string false "region"
+// @Success 200 {object} organization_service.PreviewFilialCodeResponse "PreviewFilialCodeResponseBody"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) PreviewFilialCode(c *gin.Context) {
+	resp, err := h.filial.PreviewCode(h.context(c), &organization_service.PreviewFilialCodeRequest{
+		Region: c.Query("region"),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// FindNearestFilials godoc
+// @ID find_nearest_filials
+// @Router /filial/nearest [GET]
+// @Summary Find Nearest Filials
+// @Description Get the filials within the radius of the point, nearest first
+// @Tags Filial
+// @Accept json
+// @Produce json
+// @Param lat query number true "latitude"
+// @Param lon query number true "longitude"
+// @Param radius query number false "meters, 5000 if empty"
+// @Param limit query integer false "10 if empty"
+// @Success 200 {object} organization_service.FindNearestFilialsResponse "FindNearestFilialsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) FindNearestFilials(c *gin.Context) {
+	lat, err := strconv.ParseFloat(c.Query("lat"), 64)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "lat must be a number"))
+		return
+	}
+
+	lon, err := strconv.ParseFloat(c.Query("lon"), 64)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "lon must be a number"))
+		return
+	}
+
+	radius, err := strconv.ParseFloat(c.DefaultQuery("radius", "0"), 64)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "radius must be a number"))
+		return
+	}
+
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "0"), 10, 64)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "limit must be an integer"))
+		return
+	}
+
+	resp, err := h.filial.FindNearest(h.context(c), &organization_service.FindNearestFilialsRequest{
+		Latitude:  lat,
+		Longitude: lon,
+		Radius:    radius,
+		Limit:     limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"organization_service/config"
+	"organization_service/genproto/organization_service"
+	"organization_service/pkg/logger"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type Handler struct {
+	cfg           config.Config
+	log           logger.LoggerI
+	filial        organization_service.FilialServiceClient
+	magazin       organization_service.MagazinServiceClient
+	staff         organization_service.StaffServiceClient
+	provider      organization_service.ProviderServiceClient
+	purchaseOrder organization_service.PurchaseOrderServiceClient
+}
+
+// ErrorResponse is returned for every failed request.
+type ErrorResponse struct {
+	Code       string           `json:"code"`
+	Message    string           `json:"message"`
+	Violations []FieldViolation `json:"violations,omitempty"`
+}
+
+// FieldViolation describes an invalid field of the request.
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+var (
+	marshaler   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+func NewHandler(cfg config.Config, log logger.LoggerI, conn grpc.ClientConnInterface) *Handler {
+	return &Handler{
+		cfg:           cfg,
+		log:           log,
+		filial:        organization_service.NewFilialServiceClient(conn),
+		magazin:       organization_service.NewMagazinServiceClient(conn),
+		staff:         organization_service.NewStaffServiceClient(conn),
+		provider:      organization_service.NewProviderServiceClient(conn),
+		purchaseOrder: organization_service.NewPurchaseOrderServiceClient(conn),
+	}
+}
+
+// RequestIDHeader carries the request id between the gateway, its callers and
+// the gRPC server.
+const RequestIDHeader = "X-Request-Id"
+
+// RequestID makes sure every request has an id and echoes it in the response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if id == "" {
+			id = uuid.New().String()
+			c.Request.Header.Set(RequestIDHeader, id)
+		}
+
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// context returns the context for the gRPC call of the request, forwarding
+// the request id, the address and credentials of the client and the W3C
+// trace context of the caller.
+func (h *Handler) context(c *gin.Context) context.Context {
+	ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		"x-request-id", c.GetHeader(RequestIDHeader),
+		"x-forwarded-for", c.ClientIP(),
+	)
+
+	if authorization := c.GetHeader("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	return ctx
+}
+
+func (h *Handler) handleResponse(c *gin.Context, code int, resp proto.Message, err error) {
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	body, err := marshaler.Marshal(resp)
+	if err != nil {
+		h.handleError(c, status.Error(codes.Internal, err.Error()))
+		return
+	}
+
+	c.Data(code, "application/json; charset=utf-8", body)
+}
+
+func (h *Handler) handleError(c *gin.Context, err error) {
+	st := status.Convert(err)
+
+	resp := ErrorResponse{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	}
+
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				resp.Violations = append(resp.Violations, FieldViolation{
+					Field:       violation.GetField(),
+					Description: violation.GetDescription(),
+				})
+			}
+		}
+	}
+
+	c.JSON(httpStatusFromCode(st.Code()), resp)
+}
+
+// bindBody decodes the JSON request body into req. It writes the error
+// response itself and returns false if the body is malformed.
+func (h *Handler) bindBody(c *gin.Context, req proto.Message) bool {
+	body, err := io.ReadAll(c.Request.Body)
+	if err == nil && len(body) > 0 {
+		err = unmarshaler.Unmarshal(body, req)
+	}
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, err.Error()))
+		return false
+	}
+
+	return true
+}
+
+// getListParams parses the offset and limit query parameters.
+func (h *Handler) getListParams(c *gin.Context) (offset, limit int64, ok bool) {
+	offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "offset must be an integer"))
+		return 0, 0, false
+	}
+
+	limit, err = strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "limit must be an integer"))
+		return 0, 0, false
+	}
+
+	return offset, limit, true
+}
+
+// httpStatusFromCode maps gRPC codes to HTTP statuses the same way
+// grpc-gateway does.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
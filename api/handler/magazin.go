@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"net/http"
+	"organization_service/genproto/organization_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateMagazin godoc
+// @ID create_magazin
+// @Router /magazin [POST]
+// @Summary Create Magazin
+// @Description Create Magazin
+// @Tags Magazin
+// @Accept json
+// @Produce json
+// @Param magazin body organization_service.CreateMagazin true "CreateMagazinRequestBody"
+// @Success 201 {object} organization_service.Magazin "Magazin data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) CreateMagazin(c *gin.Context) {
+	var req organization_service.CreateMagazin
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	resp, err := h.magazin.Create(h.context(c), &req)
+	h.handleResponse(c, http.StatusCreated, resp, err)
+}
+
+// GetMagazinByID godoc
+// @ID get_magazin_by_id
+// @Router /magazin/{id} [GET]
+// @Summary Get Magazin By ID
+// @Description Get Magazin By ID
+// @Tags Magazin
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} organization_service.Magazin "Magazin data"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetMagazinByID(c *gin.Context) {
+	resp, err := h.magazin.GetByID(h.context(c), &organization_service.MagazinPK{Id: c.Param("id")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetMagazinList godoc
+// @ID get_magazin_list
+// @Router /magazin [GET]
+// @Summary Get Magazins List
+// @Description Get Magazins List
+// @Tags Magazin
+// @Accept json
+// @Produce json
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param search query string false "search"
+// @Success 200 {object} organization_service.GetListMagazinResponse "GetListMagazinResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetMagazinList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.magazin.GetList(h.context(c), &organization_service.GetListMagazinRequest{
+		Offset: offset,
+		Limit:  limit,
+		Search: c.Query("search"),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdateMagazin godoc
+// @ID update_magazin
+// @Router /magazin/{id} [PUT]
+// @Summary Update Magazin
+// @Description Update Magazin
+// @Tags Magazin
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param magazin body organization_service.UpdateMagazin true "UpdateMagazinRequestBody"
+// @Success 200 {object} organization_service.Magazin "Magazin data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdateMagazin(c *gin.Context) {
+	var req organization_service.UpdateMagazin
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.magazin.Update(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdatePatchMagazin godoc
+// @ID update_patch_magazin
+// @Router /magazin/{id} [PATCH]
+// @Summary Update Patch Magazin
+// @Description Update Patch Magazin
+// @Tags Magazin
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param magazin body organization_service.UpdatePatchMagazin true "UpdatePatchMagazinRequestBody"
+// @Success 200 {object} organization_service.Magazin "Magazin data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdatePatchMagazin(c *gin.Context) {
+	var req organization_service.UpdatePatchMagazin
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.magazin.UpdatePatch(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// DeleteMagazin godoc
+// @ID delete_magazin
+// @Router /magazin/{id} [DELETE]
+// @Summary Delete Magazin
+// @Description Delete Magazin
+// @Tags Magazin
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 204
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) DeleteMagazin(c *gin.Context) {
+	_, err := h.magazin.Delete(h.context(c), &organization_service.MagazinPK{Id: c.Param("id")})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetMagazinProviderList godoc
+// @ID get_magazin_provider_list
+// @Router /magazin/{id}/providers [GET]
+// @Summary Get Providers Of Magazin
+// @Description Get Providers Of Magazin
+// @Tags Magazin
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param active_on query string false "YYYY-MM-DD"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} organization_service.ListProviderAssignmentsResponse "ListProviderAssignmentsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetMagazinProviderList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.provider.ListMagazinProviders(h.context(c), &organization_service.ListMagazinProvidersRequest{
+		MagazinId: c.Param("id"),
+		ActiveOn:  c.Query("active_on"),
+		Offset:    offset,
+		Limit:     limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
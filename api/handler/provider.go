@@ -0,0 +1,759 @@
+package handler
+
+import (
+	"net/http"
+	"organization_service/genproto/organization_service"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateProvider godoc
+// @ID create_provider
+// @Router /provider [POST]
+// @Summary Create Provider
+// @Description Create Provider
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param provider body organization_service.CreateProvider true "CreateProviderRequestBody"
+// @Success 201 {object} organization_service.Provider "Provider data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) CreateProvider(c *gin.Context) {
+	var req organization_service.CreateProvider
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	resp, err := h.provider.Create(h.context(c), &req)
+	h.handleResponse(c, http.StatusCreated, resp, err)
+}
+
+// GetProviderByID godoc
+// @ID get_provider_by_id
+// @Router /provider/{id} [GET]
+// @Summary Get Provider By ID
+// @Description Get Provider By ID
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param with_contacts query boolean false "embed the contacts of the provider"
+// @Success 200 {object} organization_service.Provider "Provider data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderByID(c *gin.Context) {
+	withContacts, err := strconv.ParseBool(c.DefaultQuery("with_contacts", "false"))
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "with_contacts must be a boolean"))
+		return
+	}
+
+	resp, err := h.provider.GetByID(h.context(c), &organization_service.ProviderPK{
+		Id:           c.Param("id"),
+		WithContacts: withContacts,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetProviderByPhone godoc
+// @ID get_provider_by_phone
+// @Router /provider/by-phone [GET]
+// @Summary Get Provider By Phone
+// @Description Get Provider By Phone
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param phone query string true "phone"
+// @Success 200 {object} organization_service.Provider "Provider data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderByPhone(c *gin.Context) {
+	resp, err := h.provider.GetByPhone(h.context(c), &organization_service.ProviderPhoneRequest{Phone: c.Query("phone")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetProviderList godoc
+// @ID get_provider_list
+// @Router /provider [GET]
+// @Summary Get Providers List
+// @Description Get Providers List
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param search query string false "search"
+// @Param sort query string false "created_at (default) or score, the best score of the default scoring period first"
+// @Success 200 {object} organization_service.GetListProviderResponse "GetListProviderResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	sort, ok := organization_service.ProviderSort_value["PROVIDER_SORT_"+strings.ToUpper(c.DefaultQuery("sort", "created_at"))]
+	if !ok {
+		h.handleError(c, status.Error(codes.InvalidArgument, "sort must be created_at or score"))
+		return
+	}
+
+	resp, err := h.provider.GetList(h.context(c), &organization_service.GetListProviderRequest{
+		Offset: offset,
+		Limit:  limit,
+		Search: c.Query("search"),
+		Sort:   organization_service.ProviderSort(sort),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdateProvider godoc
+// @ID update_provider
+// @Router /provider/{id} [PUT]
+// @Summary Update Provider
+// @Description Update Provider
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param provider body organization_service.UpdateProvider true "UpdateProviderRequestBody"
+// @Success 200 {object} organization_service.Provider "Provider data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdateProvider(c *gin.Context) {
+	var req organization_service.UpdateProvider
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.provider.Update(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdatePatchProvider godoc
+// @ID update_patch_provider
+// @Router /provider/{id} [PATCH]
+// @Summary Update Patch Provider
+// @Description Update Patch Provider
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param provider body organization_service.UpdatePatchProvider true "UpdatePatchProviderRequestBody"
+// @Success 200 {object} organization_service.Provider "Provider data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdatePatchProvider(c *gin.Context) {
+	var req organization_service.UpdatePatchProvider
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.provider.UpdatePatch(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// DeleteProvider godoc
+// @ID delete_provider
+// @Router /provider/{id} [DELETE]
+// @Summary Delete Provider
+// @Description Delete Provider
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 204
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) DeleteProvider(c *gin.Context) {
+	_, err := h.provider.Delete(h.context(c), &organization_service.ProviderPK{Id: c.Param("id")})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ChangeProviderStatus godoc
+// @ID change_provider_status
+// @Router /provider/{id}/status [POST]
+// @Summary Change Provider Status
+// @Description Moves the provider to another status if the transition is allowed
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param status body organization_service.ChangeProviderStatusRequest true "ChangeProviderStatusRequestBody"
+// @Success 200 {object} organization_service.Provider "Provider data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Response 409 {object} ErrorResponse "Conflict"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) ChangeProviderStatus(c *gin.Context) {
+	var req organization_service.ChangeProviderStatusRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.provider.ChangeStatus(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetProviderStatusHistory godoc
+// @ID get_provider_status_history
+// @Router /provider/{id}/status-history [GET]
+// @Summary Get Provider Status History
+// @Description Get Provider Status History, newest first
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} organization_service.GetProviderStatusHistoryResponse "GetProviderStatusHistoryResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderStatusHistory(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.provider.GetStatusHistory(h.context(c), &organization_service.GetProviderStatusHistoryRequest{
+		ProviderId: c.Param("id"),
+		Offset:     offset,
+		Limit:      limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// AddProviderContact godoc
+// @ID add_provider_contact
+// @Router /provider/{id}/contacts [POST]
+// @Summary Add Provider Contact
+// @Description Add Provider Contact, a primary contact replaces the previous one
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param contact body organization_service.AddProviderContactRequest true "AddProviderContactRequestBody"
+// @Success 201 {object} organization_service.ProviderContact "ProviderContact data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) AddProviderContact(c *gin.Context) {
+	var req organization_service.AddProviderContactRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.ProviderId = c.Param("id")
+
+	resp, err := h.provider.AddContact(h.context(c), &req)
+	h.handleResponse(c, http.StatusCreated, resp, err)
+}
+
+// GetProviderContactList godoc
+// @ID get_provider_contact_list
+// @Router /provider/{id}/contacts [GET]
+// @Summary Get Provider Contacts
+// @Description Get Provider Contacts, the primary one first
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} organization_service.ListProviderContactsResponse "ListProviderContactsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderContactList(c *gin.Context) {
+	resp, err := h.provider.ListContacts(h.context(c), &organization_service.ListProviderContactsRequest{ProviderId: c.Param("id")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdateProviderContact godoc
+// @ID update_provider_contact
+// @Router /provider/{id}/contacts/{contact_id} [PUT]
+// @Summary Update Provider Contact
+// @Description Update Provider Contact, a primary contact replaces the previous one
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param contact_id path string true "contact_id"
+// @Param contact body organization_service.UpdateProviderContactRequest true "UpdateProviderContactRequestBody"
+// @Success 200 {object} organization_service.ProviderContact "ProviderContact data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdateProviderContact(c *gin.Context) {
+	var req organization_service.UpdateProviderContactRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("contact_id")
+	req.ProviderId = c.Param("id")
+
+	resp, err := h.provider.UpdateContact(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// RemoveProviderContact godoc
+// @ID remove_provider_contact
+// @Router /provider/{id}/contacts/{contact_id} [DELETE]
+// @Summary Remove Provider Contact
+// @Description Remove Provider Contact
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param contact_id path string true "contact_id"
+// @Success 204
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) RemoveProviderContact(c *gin.Context) {
+	_, err := h.provider.RemoveContact(h.context(c), &organization_service.ProviderContactPK{
+		Id:         c.Param("contact_id"),
+		ProviderId: c.Param("id"),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AssignProvider godoc
+// @ID assign_provider
+// @Router /provider/{id}/magazins/{magazin_id} [PUT]
+// @Summary Assign Provider To Magazin
+// @Description Makes the provider deliver to the magazin, or changes the terms if it already does
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param magazin_id path string true "magazin_id"
+// @Param assignment body organization_service.AssignProviderRequest true "AssignProviderRequestBody"
+// @Success 200 {object} organization_service.ProviderAssignment "ProviderAssignment data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) AssignProvider(c *gin.Context) {
+	var req organization_service.AssignProviderRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.ProviderId = c.Param("id")
+	req.MagazinId = c.Param("magazin_id")
+
+	resp, err := h.provider.Assign(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UnassignProvider godoc
+// @ID unassign_provider
+// @Router /provider/{id}/magazins/{magazin_id} [DELETE]
+// @Summary Unassign Provider From Magazin
+// @Description Unassign Provider From Magazin
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param magazin_id path string true "magazin_id"
+// @Success 204
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UnassignProvider(c *gin.Context) {
+	_, err := h.provider.Unassign(h.context(c), &organization_service.UnassignProviderRequest{
+		ProviderId: c.Param("id"),
+		MagazinId:  c.Param("magazin_id"),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetProviderMagazinList godoc
+// @ID get_provider_magazin_list
+// @Router /provider/{id}/magazins [GET]
+// @Summary Get Magazins Served By Provider
+// @Description Get Magazins Served By Provider
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param filial_id query string false "filial_id"
+// @Param active_on query string false "YYYY-MM-DD"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} organization_service.ListProviderAssignmentsResponse "ListProviderAssignmentsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderMagazinList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.provider.ListProviderMagazins(h.context(c), &organization_service.ListProviderMagazinsRequest{
+		ProviderId: c.Param("id"),
+		FilialId:   c.Query("filial_id"),
+		ActiveOn:   c.Query("active_on"),
+		Offset:     offset,
+		Limit:      limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// AddProviderProduct godoc
+// @ID add_provider_product
+// @Router /provider/{id}/products [POST]
+// @Summary Add Provider Product
+// @Description Adds a product of product_service to the catalog of the provider
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param product body organization_service.AddProviderProductRequest true "AddProviderProductRequestBody"
+// @Success 201 {object} organization_service.ProviderProduct "ProviderProduct data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) AddProviderProduct(c *gin.Context) {
+	var req organization_service.AddProviderProductRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.ProviderId = c.Param("id")
+
+	resp, err := h.provider.AddProduct(h.context(c), &req)
+	h.handleResponse(c, http.StatusCreated, resp, err)
+}
+
+// GetProviderProductList godoc
+// @ID get_provider_product_list
+// @Router /provider/{id}/products [GET]
+// @Summary Get Provider Products
+// @Description Get Provider Products, the cheapest first
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} organization_service.ListProviderProductsResponse "ListProviderProductsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderProductList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.provider.ListProducts(h.context(c), &organization_service.ListProviderProductsRequest{
+		ProviderId: c.Param("id"),
+		Offset:     offset,
+		Limit:      limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetProductProviderList godoc
+// @ID get_product_provider_list
+// @Router /product/{id}/providers [GET]
+// @Summary Get Providers Of Product
+// @Description Get the providers that sell a product of product_service, the cheapest first
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "product id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} organization_service.ListProviderProductsResponse "ListProviderProductsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProductProviderList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.provider.ListProducts(h.context(c), &organization_service.ListProviderProductsRequest{
+		ProductId: c.Param("id"),
+		Offset:    offset,
+		Limit:     limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdateProviderProduct godoc
+// @ID update_provider_product
+// @Router /provider/{id}/products/{provider_product_id} [PUT]
+// @Summary Update Provider Product
+// @Description Update the terms the provider sells the product on
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param provider_product_id path string true "provider_product_id"
+// @Param product body organization_service.UpdateProviderProductRequest true "UpdateProviderProductRequestBody"
+// @Success 200 {object} organization_service.ProviderProduct "ProviderProduct data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdateProviderProduct(c *gin.Context) {
+	var req organization_service.UpdateProviderProductRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("provider_product_id")
+	req.ProviderId = c.Param("id")
+
+	resp, err := h.provider.UpdateProduct(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// RemoveProviderProduct godoc
+// @ID remove_provider_product
+// @Router /provider/{id}/products/{provider_product_id} [DELETE]
+// @Summary Remove Provider Product
+// @Description Remove Provider Product
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param provider_product_id path string true "provider_product_id"
+// @Success 204
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) RemoveProviderProduct(c *gin.Context) {
+	_, err := h.provider.RemoveProduct(h.context(c), &organization_service.ProviderProductPK{
+		Id:         c.Param("provider_product_id"),
+		ProviderId: c.Param("id"),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RecordProviderDelivery godoc
+// @ID record_provider_delivery
+// @Router /provider/{id}/deliveries [POST]
+// @Summary Record Provider Delivery
+// @Description Records the outcome of a delivery, a delivery of a received purchase order takes its dates from the order
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param delivery body organization_service.RecordProviderDeliveryRequest true "RecordProviderDeliveryRequestBody"
+// @Success 201 {object} organization_service.ProviderDelivery "ProviderDelivery data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Response 409 {object} ErrorResponse "Conflict"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) RecordProviderDelivery(c *gin.Context) {
+	var req organization_service.RecordProviderDeliveryRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.ProviderId = c.Param("id")
+
+	resp, err := h.provider.RecordDelivery(h.context(c), &req)
+	h.handleResponse(c, http.StatusCreated, resp, err)
+}
+
+// GetProviderDeliveryList godoc
+// @ID get_provider_delivery_list
+// @Router /provider/{id}/deliveries [GET]
+// @Summary Get Provider Deliveries
+// @Description Get the delivery outcomes of the provider, the latest first
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} organization_service.ListProviderDeliveriesResponse "ListProviderDeliveriesResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderDeliveryList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.provider.ListDeliveries(h.context(c), &organization_service.ListProviderDeliveriesRequest{
+		ProviderId: c.Param("id"),
+		Offset:     offset,
+		Limit:      limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// RateProvider godoc
+// @ID rate_provider
+// @Router /provider/{id}/ratings [POST]
+// @Summary Rate Provider
+// @Description Rates the provider on behalf of the caller, replacing the previous rating of the caller
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param rating body organization_service.RateProviderRequest true "RateProviderRequestBody"
+// @Success 200 {object} organization_service.ProviderRating "ProviderRating data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 401 {object} ErrorResponse "Unauthenticated"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) RateProvider(c *gin.Context) {
+	var req organization_service.RateProviderRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.ProviderId = c.Param("id")
+
+	resp, err := h.provider.Rate(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetProviderRatingList godoc
+// @ID get_provider_rating_list
+// @Router /provider/{id}/ratings [GET]
+// @Summary Get Provider Ratings
+// @Description Get the ratings of the provider, the latest first
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} organization_service.ListProviderRatingsResponse "ListProviderRatingsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderRatingList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.provider.ListRatings(h.context(c), &organization_service.ListProviderRatingsRequest{
+		ProviderId: c.Param("id"),
+		Offset:     offset,
+		Limit:      limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetProviderScorecard godoc
+// @ID get_provider_scorecard
+// @Router /provider/{id}/scorecard [GET]
+// @Summary Get Provider Scorecard
+// @Description Sums up the deliveries and the ratings of the provider in the last period_days days
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param period_days query integer false "the default scoring period if empty"
+// @Success 200 {object} organization_service.ProviderScorecard "ProviderScorecard data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetProviderScorecard(c *gin.Context) {
+	periodDays, err := strconv.ParseInt(c.DefaultQuery("period_days", "0"), 10, 32)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "period_days must be an integer"))
+		return
+	}
+
+	resp, err := h.provider.GetScorecard(h.context(c), &organization_service.GetProviderScorecardRequest{
+		Id:         c.Param("id"),
+		PeriodDays: int32(periodDays),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// FindProviderDuplicates godoc
+// @ID find_provider_duplicates
+// @Router /provider/duplicates [GET]
+// @Summary Find Provider Duplicates
+// @Description Clusters the providers sharing a phone or an INN, or having similar names
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param name_similarity query number false "from 0 to 1, 0.85 if empty"
+// @Param limit query integer false "the number of clusters, all if empty"
+// @Success 200 {object} organization_service.FindProviderDuplicatesResponse "FindProviderDuplicatesResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) FindProviderDuplicates(c *gin.Context) {
+	nameSimilarity, err := strconv.ParseFloat(c.DefaultQuery("name_similarity", "0"), 64)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "name_similarity must be a number"))
+		return
+	}
+
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "0"), 10, 64)
+	if err != nil {
+		h.handleError(c, status.Error(codes.InvalidArgument, "limit must be an integer"))
+		return
+	}
+
+	resp, err := h.provider.FindDuplicates(h.context(c), &organization_service.FindProviderDuplicatesRequest{
+		NameSimilarity: nameSimilarity,
+		Limit:          limit,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// MergeProviders godoc
+// @ID merge_providers
+// @Router /provider/{id}/merge [POST]
+// @Summary Merge Providers
+// @Description Merges the source providers into the provider, their ids keep resolving to it
+// @Tags Provider
+// @Accept json
+// @Produce json
+// @Param id path string true "id of the provider to keep"
+// @Param merge body organization_service.MergeProvidersRequest true "MergeProvidersRequestBody"
+// @Success 200 {object} organization_service.Provider "Provider data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) MergeProviders(c *gin.Context) {
+	var req organization_service.MergeProvidersRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.TargetId = c.Param("id")
+
+	resp, err := h.provider.Merge(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
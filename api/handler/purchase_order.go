@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"net/http"
+	"organization_service/genproto/organization_service"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreatePurchaseOrder godoc
+// @ID create_purchase_order
+// @Router /purchase-order [POST]
+// @Summary Create Purchase Order
+// @Description Creates a draft order of a magazin to a provider, items without a unit price take the price of the provider catalog
+// @Tags PurchaseOrder
+// @Accept json
+// @Produce json
+// @Param purchase_order body organization_service.CreatePurchaseOrder true "CreatePurchaseOrderRequestBody"
+// @Success 201 {object} organization_service.PurchaseOrder "PurchaseOrder data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) CreatePurchaseOrder(c *gin.Context) {
+	var req organization_service.CreatePurchaseOrder
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	resp, err := h.purchaseOrder.Create(h.context(c), &req)
+	h.handleResponse(c, http.StatusCreated, resp, err)
+}
+
+// GetPurchaseOrderByID godoc
+// @ID get_purchase_order_by_id
+// @Router /purchase-order/{id} [GET]
+// @Summary Get Purchase Order By ID
+// @Description Get Purchase Order By ID with its items
+// @Tags PurchaseOrder
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} organization_service.PurchaseOrder "PurchaseOrder data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetPurchaseOrderByID(c *gin.Context) {
+	resp, err := h.purchaseOrder.GetByID(h.context(c), &organization_service.PurchaseOrderPK{Id: c.Param("id")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetPurchaseOrderList godoc
+// @ID get_purchase_order_list
+// @Router /purchase-order [GET]
+// @Summary Get Purchase Orders List
+// @Description Get Purchase Orders List without their items, the newest first
+// @Tags PurchaseOrder
+// @Accept json
+// @Produce json
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param magazin_id query string false "magazin_id"
+// @Param provider_id query string false "provider_id"
+// @Param status query []string false "draft, submitted, confirmed, received or cancelled" collectionFormat(multi)
+// @Success 200 {object} organization_service.GetListPurchaseOrderResponse "GetListPurchaseOrderResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetPurchaseOrderList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	var statuses []organization_service.PurchaseOrderStatus
+	for _, name := range c.QueryArray("status") {
+		value, ok := organization_service.PurchaseOrderStatus_value["PURCHASE_ORDER_STATUS_"+strings.ToUpper(name)]
+		if !ok {
+			h.handleError(c, status.Errorf(codes.InvalidArgument, "unknown purchase order status %q", name))
+			return
+		}
+
+		statuses = append(statuses, organization_service.PurchaseOrderStatus(value))
+	}
+
+	resp, err := h.purchaseOrder.GetList(h.context(c), &organization_service.GetListPurchaseOrderRequest{
+		Offset:     offset,
+		Limit:      limit,
+		MagazinId:  c.Query("magazin_id"),
+		ProviderId: c.Query("provider_id"),
+		Statuses:   statuses,
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdatePurchaseOrder godoc
+// @ID update_purchase_order
+// @Router /purchase-order/{id} [PUT]
+// @Summary Update Purchase Order
+// @Description Replaces the terms and the items of a draft order
+// @Tags PurchaseOrder
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param purchase_order body organization_service.UpdatePurchaseOrder true "UpdatePurchaseOrderRequestBody"
+// @Success 200 {object} organization_service.PurchaseOrder "PurchaseOrder data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Response 409 {object} ErrorResponse "Conflict"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdatePurchaseOrder(c *gin.Context) {
+	var req organization_service.UpdatePurchaseOrder
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.purchaseOrder.Update(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// DeletePurchaseOrder godoc
+// @ID delete_purchase_order
+// @Router /purchase-order/{id} [DELETE]
+// @Summary Delete Purchase Order
+// @Description Deletes a draft order, submitted orders are cancelled instead
+// @Tags PurchaseOrder
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 204
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Response 409 {object} ErrorResponse "Conflict"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) DeletePurchaseOrder(c *gin.Context) {
+	_, err := h.purchaseOrder.Delete(h.context(c), &organization_service.PurchaseOrderPK{Id: c.Param("id")})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ChangePurchaseOrderStatus godoc
+// @ID change_purchase_order_status
+// @Router /purchase-order/{id}/status [POST]
+// @Summary Change Purchase Order Status
+// @Description Moves the order to another status if the transition is allowed
+// @Tags PurchaseOrder
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param status body organization_service.ChangePurchaseOrderStatusRequest true "ChangePurchaseOrderStatusRequestBody"
+// @Success 200 {object} organization_service.PurchaseOrder "PurchaseOrder data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Response 409 {object} ErrorResponse "Conflict"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) ChangePurchaseOrderStatus(c *gin.Context) {
+	var req organization_service.ChangePurchaseOrderStatusRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.purchaseOrder.ChangeStatus(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
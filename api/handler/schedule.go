@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"net/http"
+	"organization_service/genproto/organization_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetFilialWeeklyHours godoc
+// @ID set_filial_weekly_hours
+// @Router /filial/{id}/hours [PUT]
+// @Summary Set Filial Weekly Hours
+// @Description Replaces the weekly hours of the filial, weekdays without hours are closed
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param hours body organization_service.SetWeeklyHoursRequest true "SetWeeklyHoursRequestBody"
+// @Success 200 {object} organization_service.WeeklyHours "WeeklyHours data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) SetFilialWeeklyHours(c *gin.Context) {
+	h.setWeeklyHours(c, c.Param("id"), "")
+}
+
+// SetMagazinWeeklyHours godoc
+// @ID set_magazin_weekly_hours
+// @Router /magazin/{id}/hours [PUT]
+// @Summary Set Magazin Weekly Hours
+// @Description Replaces the weekly hours of the magazin, empty hours make it follow its filial
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param hours body organization_service.SetWeeklyHoursRequest true "SetWeeklyHoursRequestBody"
+// @Success 200 {object} organization_service.WeeklyHours "WeeklyHours data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) SetMagazinWeeklyHours(c *gin.Context) {
+	h.setWeeklyHours(c, "", c.Param("id"))
+}
+
+func (h *Handler) setWeeklyHours(c *gin.Context, filialID, magazinID string) {
+	var req organization_service.SetWeeklyHoursRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.FilialId, req.MagazinId = filialID, magazinID
+
+	resp, err := h.filial.SetWeeklyHours(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetFilialWeeklyHours godoc
+// @ID get_filial_weekly_hours
+// @Router /filial/{id}/hours [GET]
+// @Summary Get Filial Weekly Hours
+// @Description Get the weekly hours of the filial
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} organization_service.WeeklyHours "WeeklyHours data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetFilialWeeklyHours(c *gin.Context) {
+	resp, err := h.filial.GetWeeklyHours(h.context(c), &organization_service.GetWeeklyHoursRequest{FilialId: c.Param("id")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetMagazinWeeklyHours godoc
+// @ID get_magazin_weekly_hours
+// @Router /magazin/{id}/hours [GET]
+// @Summary Get Magazin Weekly Hours
+// @Description Get the weekly hours of the magazin, those of its filial if it has none of its own
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} organization_service.WeeklyHours "WeeklyHours data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetMagazinWeeklyHours(c *gin.Context) {
+	resp, err := h.filial.GetWeeklyHours(h.context(c), &organization_service.GetWeeklyHoursRequest{MagazinId: c.Param("id")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// SetFilialScheduleException godoc
+// @ID set_filial_schedule_exception
+// @Router /filial/{id}/exceptions/{date} [PUT]
+// @Summary Set Filial Schedule Exception
+// @Description Sets the hours of the filial on the date, closed for a holiday
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param date path string true "YYYY-MM-DD"
+// @Param exception body organization_service.SetScheduleExceptionRequest true "SetScheduleExceptionRequestBody"
+// @Success 200 {object} organization_service.ScheduleException "ScheduleException data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) SetFilialScheduleException(c *gin.Context) {
+	h.setScheduleException(c, c.Param("id"), "")
+}
+
+// SetMagazinScheduleException godoc
+// @ID set_magazin_schedule_exception
+// @Router /magazin/{id}/exceptions/{date} [PUT]
+// @Summary Set Magazin Schedule Exception
+// @Description Sets the hours of the magazin on the date, over any exception of its filial
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param date path string true "YYYY-MM-DD"
+// @Param exception body organization_service.SetScheduleExceptionRequest true "SetScheduleExceptionRequestBody"
+// @Success 200 {object} organization_service.ScheduleException "ScheduleException data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) SetMagazinScheduleException(c *gin.Context) {
+	h.setScheduleException(c, "", c.Param("id"))
+}
+
+func (h *Handler) setScheduleException(c *gin.Context, filialID, magazinID string) {
+	var req organization_service.SetScheduleExceptionRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.FilialId, req.MagazinId = filialID, magazinID
+	req.Date = c.Param("date")
+
+	resp, err := h.filial.SetScheduleException(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetFilialScheduleExceptionList godoc
+// @ID get_filial_schedule_exception_list
+// @Router /filial/{id}/exceptions [GET]
+// @Summary Get Filial Schedule Exceptions
+// @Description Get the exceptions of the filial between the dates, by date
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param date_from query string false "YYYY-MM-DD"
+// @Param date_to query string false "YYYY-MM-DD"
+// @Success 200 {object} organization_service.ListScheduleExceptionsResponse "ListScheduleExceptionsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetFilialScheduleExceptionList(c *gin.Context) {
+	resp, err := h.filial.ListScheduleExceptions(h.context(c), &organization_service.ListScheduleExceptionsRequest{
+		FilialId: c.Param("id"),
+		DateFrom: c.Query("date_from"),
+		DateTo:   c.Query("date_to"),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetMagazinScheduleExceptionList godoc
+// @ID get_magazin_schedule_exception_list
+// @Router /magazin/{id}/exceptions [GET]
+// @Summary Get Magazin Schedule Exceptions
+// @Description Get the exceptions of the magazin itself between the dates, by date
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param date_from query string false "YYYY-MM-DD"
+// @Param date_to query string false "YYYY-MM-DD"
+// @Success 200 {object} organization_service.ListScheduleExceptionsResponse "ListScheduleExceptionsResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetMagazinScheduleExceptionList(c *gin.Context) {
+	resp, err := h.filial.ListScheduleExceptions(h.context(c), &organization_service.ListScheduleExceptionsRequest{
+		MagazinId: c.Param("id"),
+		DateFrom:  c.Query("date_from"),
+		DateTo:    c.Query("date_to"),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// RemoveScheduleException godoc
+// @ID remove_schedule_exception
+// @Router /schedule-exception/{id} [DELETE]
+// @Summary Remove Schedule Exception
+// @Description Removes the exception, the date follows the weekly hours again
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 204
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) RemoveScheduleException(c *gin.Context) {
+	_, err := h.filial.RemoveScheduleException(h.context(c), &organization_service.ScheduleExceptionPK{Id: c.Param("id")})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// IsOpen godoc
+// @ID is_open
+// @Router /schedule/{id}/open [GET]
+// @Summary Is Open
+// @Description Reports whether the filial or the magazin is open at the time
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id of a filial or a magazin"
+// @Param at query string false "RFC 3339, now if empty"
+// @Success 200 {object} organization_service.IsOpenResponse "IsOpenResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) IsOpen(c *gin.Context) {
+	resp, err := h.filial.IsOpen(h.context(c), &organization_service.IsOpenRequest{
+		Id: c.Param("id"),
+		At: c.Query("at"),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetSchedule godoc
+// @ID get_schedule
+// @Router /schedule/{id} [GET]
+// @Summary Get Schedule
+// @Description Get the effective hours of the filial or the magazin on each date of the range
+// @Tags Schedule
+// @Accept json
+// @Produce json
+// @Param id path string true "id of a filial or a magazin"
+// @Param date_from query string true "YYYY-MM-DD"
+// @Param date_to query string true "YYYY-MM-DD"
+// @Success 200 {object} organization_service.GetScheduleResponse "GetScheduleResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetSchedule(c *gin.Context) {
+	resp, err := h.filial.GetSchedule(h.context(c), &organization_service.GetScheduleRequest{
+		Id:       c.Param("id"),
+		DateFrom: c.Query("date_from"),
+		DateTo:   c.Query("date_to"),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
@@ -0,0 +1,352 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"organization_service/genproto/organization_service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+)
+
+// CreateStaff godoc
+// @ID create_staff
+// @Router /staff [POST]
+// @Summary Create Staff
+// @Description Create Staff
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param staff body organization_service.CreateStaff true "CreateStaffRequestBody"
+// @Success 201 {object} organization_service.Staff "Staff data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) CreateStaff(c *gin.Context) {
+	var req organization_service.CreateStaff
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	resp, err := h.staff.Create(h.context(c), &req)
+	h.handleResponse(c, http.StatusCreated, resp, err)
+}
+
+// GetStaffByID godoc
+// @ID get_staff_by_id
+// @Router /staff/{id} [GET]
+// @Summary Get Staff By ID
+// @Description Get Staff By ID
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} organization_service.Staff "Staff data"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetStaffByID(c *gin.Context) {
+	resp, err := h.staff.GetByID(h.context(c), &organization_service.StaffPK{Id: c.Param("id")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetStaffByPhone godoc
+// @ID get_staff_by_phone
+// @Router /staff/by-phone [GET]
+// @Summary Get Staff By Phone
+// @Description Get Staff By Phone
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param phone query string true "phone"
+// @Success 200 {object} organization_service.Staff "Staff data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetStaffByPhone(c *gin.Context) {
+	resp, err := h.staff.GetByPhone(h.context(c), &organization_service.StaffPhoneRequest{Phone: c.Query("phone")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// GetStaffList godoc
+// @ID get_staff_list
+// @Router /staff [GET]
+// @Summary Get Staffs List
+// @Description Get Staffs List
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param search query string false "search"
+// @Success 200 {object} organization_service.GetListStaffResponse "GetListStaffResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) GetStaffList(c *gin.Context) {
+	offset, limit, ok := h.getListParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.staff.GetList(h.context(c), &organization_service.GetListStaffRequest{
+		Offset: offset,
+		Limit:  limit,
+		Search: c.Query("search"),
+	})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdateStaff godoc
+// @ID update_staff
+// @Router /staff/{id} [PUT]
+// @Summary Update Staff
+// @Description Update Staff
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param staff body organization_service.UpdateStaff true "UpdateStaffRequestBody"
+// @Success 200 {object} organization_service.Staff "Staff data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdateStaff(c *gin.Context) {
+	var req organization_service.UpdateStaff
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.staff.Update(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// UpdatePatchStaff godoc
+// @ID update_patch_staff
+// @Router /staff/{id} [PATCH]
+// @Summary Update Patch Staff
+// @Description Update Patch Staff
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param staff body organization_service.UpdatePatchStaff true "UpdatePatchStaffRequestBody"
+// @Success 200 {object} organization_service.Staff "Staff data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UpdatePatchStaff(c *gin.Context) {
+	var req organization_service.UpdatePatchStaff
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.staff.UpdatePatch(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// DeleteStaff godoc
+// @ID delete_staff
+// @Router /staff/{id} [DELETE]
+// @Summary Delete Staff
+// @Description Delete Staff
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 204
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) DeleteStaff(c *gin.Context) {
+	_, err := h.staff.Delete(h.context(c), &organization_service.StaffPK{Id: c.Param("id")})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SuspendStaff godoc
+// @ID suspend_staff
+// @Router /staff/{id}/suspend [POST]
+// @Summary Suspend Staff
+// @Description Suspend Staff
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param status body organization_service.StaffStatusRequest true "StaffStatusRequestBody"
+// @Success 200 {object} organization_service.Staff "Staff data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 409 {object} ErrorResponse "Conflict"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) SuspendStaff(c *gin.Context) {
+	h.changeStaffStatus(c, h.staff.Suspend)
+}
+
+// ReactivateStaff godoc
+// @ID reactivate_staff
+// @Router /staff/{id}/reactivate [POST]
+// @Summary Reactivate Staff
+// @Description Reactivate Staff
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param status body organization_service.StaffStatusRequest true "StaffStatusRequestBody"
+// @Success 200 {object} organization_service.Staff "Staff data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 409 {object} ErrorResponse "Conflict"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) ReactivateStaff(c *gin.Context) {
+	h.changeStaffStatus(c, h.staff.Reactivate)
+}
+
+// TerminateStaff godoc
+// @ID terminate_staff
+// @Router /staff/{id}/terminate [POST]
+// @Summary Terminate Staff
+// @Description Terminate Staff
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Param status body organization_service.StaffStatusRequest true "StaffStatusRequestBody"
+// @Success 200 {object} organization_service.Staff "Staff data"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 409 {object} ErrorResponse "Conflict"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) TerminateStaff(c *gin.Context) {
+	h.changeStaffStatus(c, h.staff.Terminate)
+}
+
+// UnlockStaff godoc
+// @ID unlock_staff
+// @Router /staff/{id}/unlock [POST]
+// @Summary Unlock Staff
+// @Description Lifts the lockout of a staff after failed logins
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param id path string true "id"
+// @Success 200 {object} organization_service.Staff "Staff data"
+// @Response 404 {object} ErrorResponse "Not Found"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) UnlockStaff(c *gin.Context) {
+	resp, err := h.staff.Unlock(h.context(c), &organization_service.StaffPK{Id: c.Param("id")})
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+func (h *Handler) changeStaffStatus(c *gin.Context, change func(context.Context, *organization_service.StaffStatusRequest, ...grpc.CallOption) (*organization_service.Staff, error)) {
+	var req organization_service.StaffStatusRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+	req.Id = c.Param("id")
+
+	resp, err := change(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// LoginStaff godoc
+// @ID login_staff
+// @Router /staff/login [POST]
+// @Summary Login Staff
+// @Description Login Staff
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param login body organization_service.StaffLoginRequest true "StaffLoginRequestBody"
+// @Success 200 {object} organization_service.StaffLoginResponse "StaffLoginResponseBody"
+// @Response 401 {object} ErrorResponse "Unauthenticated"
+// @Response 403 {object} ErrorResponse "Permission Denied"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) LoginStaff(c *gin.Context) {
+	var req organization_service.StaffLoginRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	resp, err := h.staff.Login(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// ValidateStaffToken godoc
+// @ID validate_staff_token
+// @Router /staff/validate-token [POST]
+// @Summary Validate Staff Token
+// @Description Validate Staff Token
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param token body organization_service.ValidateStaffTokenRequest true "ValidateStaffTokenRequestBody"
+// @Success 200 {object} organization_service.ValidateStaffTokenResponse "ValidateStaffTokenResponseBody"
+// @Response 401 {object} ErrorResponse "Unauthenticated"
+// @Response 403 {object} ErrorResponse "Permission Denied"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) ValidateStaffToken(c *gin.Context) {
+	var req organization_service.ValidateStaffTokenRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	resp, err := h.staff.ValidateToken(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// RequestStaffPasswordReset godoc
+// @ID request_staff_password_reset
+// @Router /staff/password-reset [POST]
+// @Summary Request Staff Password Reset
+// @Description Sends a one-time code to the phone of the staff found by login or phone
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param reset body organization_service.RequestPasswordResetRequest true "RequestPasswordResetRequestBody"
+// @Success 200 {object} organization_service.RequestPasswordResetResponse "RequestPasswordResetResponseBody"
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 429 {object} ErrorResponse "Too Many Requests"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) RequestStaffPasswordReset(c *gin.Context) {
+	var req organization_service.RequestPasswordResetRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	resp, err := h.staff.RequestPasswordReset(h.context(c), &req)
+	h.handleResponse(c, http.StatusOK, resp, err)
+}
+
+// ConfirmStaffPasswordReset godoc
+// @ID confirm_staff_password_reset
+// @Router /staff/password-reset/confirm [POST]
+// @Summary Confirm Staff Password Reset
+// @Description Sets a new password with the one-time code sent by RequestStaffPasswordReset
+// @Tags Staff
+// @Accept json
+// @Produce json
+// @Param confirm body organization_service.ConfirmPasswordResetRequest true "ConfirmPasswordResetRequestBody"
+// @Success 204
+// @Response 400 {object} ErrorResponse "Invalid Argument"
+// @Response 429 {object} ErrorResponse "Too Many Requests"
+// @Failure 500 {object} ErrorResponse "Server Error"
+func (h *Handler) ConfirmStaffPasswordReset(c *gin.Context) {
+	var req organization_service.ConfirmPasswordResetRequest
+
+	if !h.bindBody(c, &req) {
+		return
+	}
+
+	_, err := h.staff.ConfirmPasswordReset(h.context(c), &req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -2,12 +2,25 @@ package main
 
 import (
 	"context"
+	"errors"
 	"net"
+	"net/http"
+	"organization_service/api"
 	"organization_service/config"
 	"organization_service/grpc"
 	"organization_service/grpc/client"
+	"organization_service/migrations"
 	"organization_service/pkg/logger"
+	"organization_service/pkg/tracing"
+	"organization_service/pkg/webhook"
 	"organization_service/storage/postgres"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	// time zones of filials are loaded from the binary on hosts without
+	// tzdata
+	_ "time/tzdata"
 
 	"github.com/gin-gonic/gin"
 )
@@ -29,9 +42,37 @@ func main() {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	log := logger.NewLogger(cfg.ServiceName, loggerLevel)
+	log := logger.NewLogger(cfg.ServiceName, loggerLevel, cfg.LogFormat)
 	defer logger.Cleanup(log)
 
+	logger.RedactFields(cfg.LogRedactFields...)
+	logger.SetDefault(log)
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrations.Run(cfg, os.Stdout, os.Args[2:]); err != nil {
+			log.Fatal("migrations.Run", logger.Error(err))
+		}
+		return
+	}
+
+	if cfg.JWTSecret == "" {
+		log.Fatal("JWT_SECRET must be set to sign and validate access tokens")
+	}
+
+	shutdownTracing, err := tracing.Init(context.Background(), cfg)
+	if err != nil {
+		log.Panic("tracing.Init", logger.Error(err))
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Error("tracing.Shutdown", logger.Error(err))
+		}
+	}()
+
+	if err = migrations.Check(cfg, cfg.PostgresAutoMigrate); err != nil {
+		log.Panic("migrations.Check", logger.Error(err))
+	}
+
 	pgStore, err := postgres.NewPostgres(context.Background(), cfg)
 	if err != nil {
 		log.Panic("postgres.NewPostgres", logger.Error(err))
@@ -43,16 +84,90 @@ func main() {
 		log.Panic("client.NewGrpcClients", logger.Error(err))
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var workers sync.WaitGroup
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		webhook.NewDispatcher(cfg, log, pgStore.Webhook()).Run(ctx)
+	}()
+
 	grpcServer := grpc.SetUpServer(cfg, log, pgStore, svcs)
 
+	healthChecker := grpc.SetUpHealth(cfg, log, grpcServer, pgStore)
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		healthChecker.Run(ctx)
+	}()
+
 	lis, err := net.Listen("tcp", cfg.ServicePort)
 	if err != nil {
 		log.Panic("net.Listen", logger.Error(err))
 	}
 
-	log.Info("GRPC: Server being started...", logger.String("port", cfg.ServicePort))
+	conn, err := api.DialServer(cfg)
+	if err != nil {
+		log.Panic("api.DialServer", logger.Error(err))
+	}
+	defer conn.Close()
+
+	httpServer := &http.Server{
+		Addr:    cfg.HTTPPort,
+		Handler: api.SetUpAPI(cfg, log, conn),
+	}
+
+	go func() {
+		log.Info("HTTP: Server being started...", logger.String("port", cfg.HTTPPort))
+
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("httpServer.ListenAndServe", logger.Error(err))
+			stop()
+		}
+	}()
+
+	go func() {
+		log.Info("GRPC: Server being started...", logger.String("port", cfg.ServicePort))
+
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Error("grpcServer.Serve", logger.Error(err))
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+
+	log.Info("Shutting down...", logger.String("timeout", cfg.ShutdownTimeout.String()))
+
+	healthChecker.Shutdown()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		log.Error("httpServer.Shutdown", logger.Error(err))
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
 
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Panic("grpcServer.Serve", logger.Error(err))
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		log.Warn("GRPC: drain timeout exceeded, closing remaining connections")
+		grpcServer.Stop()
 	}
+
+	// The pool is closed by the deferred CloseDB only after the server and
+	// the background workers have stopped using it.
+	workers.Wait()
+
+	log.Info("Server stopped")
 }
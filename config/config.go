@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -21,13 +23,30 @@ type Config struct {
 	ServiceName string
 	ServiceHost string
 	ServicePort string
+	HTTPPort    string
+
+	HTTPTrustedProxies []string // proxies in front of the REST gateway trusted with X-Forwarded-For
+	GRPCTrustedProxies []string // peers trusted with the x-forwarded-for metadata, the REST gateway
 
 	Environment string // debug, test, release
 	Version     string
 
+	LogFormat       string   // console, json
+	LogRedactFields []string // extra field names masked in logs
+
+	HealthCheckInterval time.Duration
+	ShutdownTimeout     time.Duration
+
+	TracingExporter    string // none, stdout, otlp
+	TracingSampleRatio float64
+	OTLPEndpoint       string
+
 	UserServiceHost string
 	UserServicePort string
 
+	ProductServiceHost string
+	ProductServicePort string
+
 	PostgresHost     string
 	PostgresPort     int
 	PostgresUser     string
@@ -35,6 +54,43 @@ type Config struct {
 	PostgresDatabase string
 
 	PostgresMaxConnections int32
+	PostgresAutoMigrate    bool
+
+	PhoneDefaultCountryCode string
+
+	JWTSecret      string
+	AccessTokenTTL time.Duration
+
+	LoginMaxFailures   int // failed logins in a row before the staff is locked out
+	LoginLockoutBase   time.Duration
+	LoginLockoutMax    time.Duration
+	LoginIPMaxFailures int // failed logins per client IP in LoginIPWindow
+	LoginIPWindow      time.Duration
+
+	SMSSender string // console, fake
+
+	OTPLength         int
+	OTPTTL            time.Duration
+	OTPMaxAttempts    int
+	OTPResendInterval time.Duration
+	OTPRateLimit      int // resets per phone in OTPRateWindow
+	OTPRateWindow     time.Duration
+
+	ProviderScorePeriodDays int32 // default period of provider scores
+
+	FilialCodePattern       string
+	FilialCodePrefix        string
+	FilialCodeDefaultRegion string
+	FilialCodeSequenceWidth int
+
+	FilialDefaultTimeZone string // IANA time zone of filials created without one
+
+	WebhookMaxAttempts  int
+	WebhookBackoffBase  time.Duration
+	WebhookBackoffMax   time.Duration
+	WebhookPollInterval time.Duration
+	WebhookTimeout      time.Duration
+	WebhookBatchSize    int
 }
 
 // Load ...
@@ -48,10 +104,27 @@ func Load() Config {
 	config.ServiceName = cast.ToString(getOrReturnDefaultValue("SERVICE_NAME", "market_organization_service"))
 	config.ServiceHost = cast.ToString(getOrReturnDefaultValue("SERVICE_HOST", "localhost"))
 	config.ServicePort = cast.ToString(getOrReturnDefaultValue("SERVICE_PORT", ":9091"))
+	config.HTTPPort = cast.ToString(getOrReturnDefaultValue("HTTP_PORT", ":8091"))
+
+	config.HTTPTrustedProxies = splitList(cast.ToString(getOrReturnDefaultValue("HTTP_TRUSTED_PROXIES", "")))
+	config.GRPCTrustedProxies = splitList(cast.ToString(getOrReturnDefaultValue("GRPC_TRUSTED_PROXIES", "127.0.0.1,::1")))
 
 	config.Environment = cast.ToString(getOrReturnDefaultValue("ENVIRONMENT", DebugMode))
 	config.Version = cast.ToString(getOrReturnDefaultValue("VERSION", "1.0"))
 
+	config.LogFormat = cast.ToString(getOrReturnDefaultValue("LOG_FORMAT", "console"))
+	config.LogRedactFields = splitList(cast.ToString(getOrReturnDefaultValue("LOG_REDACT_FIELDS", "")))
+
+	config.HealthCheckInterval = cast.ToDuration(getOrReturnDefaultValue("HEALTH_CHECK_INTERVAL", "5s"))
+	config.ShutdownTimeout = cast.ToDuration(getOrReturnDefaultValue("SHUTDOWN_TIMEOUT", "30s"))
+
+	config.TracingExporter = cast.ToString(getOrReturnDefaultValue("TRACING_EXPORTER", "none"))
+	config.TracingSampleRatio = cast.ToFloat64(getOrReturnDefaultValue("TRACING_SAMPLE_RATIO", 1.0))
+	config.OTLPEndpoint = cast.ToString(getOrReturnDefaultValue("OTLP_ENDPOINT", "localhost:4317"))
+
+	config.ProductServiceHost = cast.ToString(getOrReturnDefaultValue("PRODUCT_SERVICE_HOST", "localhost"))
+	config.ProductServicePort = cast.ToString(getOrReturnDefaultValue("PRODUCT_SERVICE_PORT", ":9092"))
+
 	config.PostgresHost = cast.ToString(getOrReturnDefaultValue("POSTGRES_HOST", "0.0.0.0"))
 	config.PostgresPort = cast.ToInt(getOrReturnDefaultValue("POSTGRES_PORT", 5432))
 	config.PostgresUser = cast.ToString(getOrReturnDefaultValue("POSTGRES_USER", "abdurahmon"))
@@ -59,6 +132,44 @@ func Load() Config {
 	config.PostgresDatabase = cast.ToString(getOrReturnDefaultValue("POSTGRES_DATABASE", config.ServiceName))
 
 	config.PostgresMaxConnections = cast.ToInt32(getOrReturnDefaultValue("POSTGRES_MAX_CONNECTIONS", 30))
+	config.PostgresAutoMigrate = cast.ToBool(getOrReturnDefaultValue("POSTGRES_AUTO_MIGRATE", false))
+
+	// JWTSecret has no default, the service refuses to start without it
+	config.JWTSecret = cast.ToString(getOrReturnDefaultValue("JWT_SECRET", ""))
+	config.AccessTokenTTL = cast.ToDuration(getOrReturnDefaultValue("ACCESS_TOKEN_TTL", "12h"))
+
+	config.LoginMaxFailures = cast.ToInt(getOrReturnDefaultValue("LOGIN_MAX_FAILURES", 5))
+	config.LoginLockoutBase = cast.ToDuration(getOrReturnDefaultValue("LOGIN_LOCKOUT_BASE", "1m"))
+	config.LoginLockoutMax = cast.ToDuration(getOrReturnDefaultValue("LOGIN_LOCKOUT_MAX", "24h"))
+	config.LoginIPMaxFailures = cast.ToInt(getOrReturnDefaultValue("LOGIN_IP_MAX_FAILURES", 20))
+	config.LoginIPWindow = cast.ToDuration(getOrReturnDefaultValue("LOGIN_IP_WINDOW", "15m"))
+
+	config.SMSSender = cast.ToString(getOrReturnDefaultValue("SMS_SENDER", "console"))
+
+	config.OTPLength = cast.ToInt(getOrReturnDefaultValue("OTP_LENGTH", 6))
+	config.OTPTTL = cast.ToDuration(getOrReturnDefaultValue("OTP_TTL", "5m"))
+	config.OTPMaxAttempts = cast.ToInt(getOrReturnDefaultValue("OTP_MAX_ATTEMPTS", 5))
+	config.OTPResendInterval = cast.ToDuration(getOrReturnDefaultValue("OTP_RESEND_INTERVAL", "1m"))
+	config.OTPRateLimit = cast.ToInt(getOrReturnDefaultValue("OTP_RATE_LIMIT", 3))
+	config.OTPRateWindow = cast.ToDuration(getOrReturnDefaultValue("OTP_RATE_WINDOW", "1h"))
+
+	config.PhoneDefaultCountryCode = cast.ToString(getOrReturnDefaultValue("PHONE_DEFAULT_COUNTRY_CODE", "998"))
+
+	config.ProviderScorePeriodDays = cast.ToInt32(getOrReturnDefaultValue("PROVIDER_SCORE_PERIOD_DAYS", 90))
+
+	config.FilialCodePattern = cast.ToString(getOrReturnDefaultValue("FILIAL_CODE_PATTERN", "{prefix}-{region}-{seq}"))
+	config.FilialCodePrefix = cast.ToString(getOrReturnDefaultValue("FILIAL_CODE_PREFIX", "FL"))
+	config.FilialCodeDefaultRegion = cast.ToString(getOrReturnDefaultValue("FILIAL_CODE_DEFAULT_REGION", "UZ"))
+	config.FilialCodeSequenceWidth = cast.ToInt(getOrReturnDefaultValue("FILIAL_CODE_SEQUENCE_WIDTH", 4))
+
+	config.FilialDefaultTimeZone = cast.ToString(getOrReturnDefaultValue("FILIAL_DEFAULT_TIME_ZONE", "Asia/Tashkent"))
+
+	config.WebhookMaxAttempts = cast.ToInt(getOrReturnDefaultValue("WEBHOOK_MAX_ATTEMPTS", 8))
+	config.WebhookBackoffBase = cast.ToDuration(getOrReturnDefaultValue("WEBHOOK_BACKOFF_BASE", "10s"))
+	config.WebhookBackoffMax = cast.ToDuration(getOrReturnDefaultValue("WEBHOOK_BACKOFF_MAX", "1h"))
+	config.WebhookPollInterval = cast.ToDuration(getOrReturnDefaultValue("WEBHOOK_POLL_INTERVAL", "5s"))
+	config.WebhookTimeout = cast.ToDuration(getOrReturnDefaultValue("WEBHOOK_TIMEOUT", "10s"))
+	config.WebhookBatchSize = cast.ToInt(getOrReturnDefaultValue("WEBHOOK_BATCH_SIZE", 20))
 
 	return config
 }
@@ -72,3 +183,10 @@ func getOrReturnDefaultValue(key string, defaultValue interface{}) interface{} {
 
 	return defaultValue
 }
+
+// splitList splits a comma or space separated list.
+func splitList(list string) []string {
+	return strings.FieldsFunc(list, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
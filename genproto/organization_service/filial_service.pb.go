@@ -28,7 +28,7 @@ var file_filial_service_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x0c, 0x66, 0x69,
 	0x6c, 0x69, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xec, 0x03, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x69,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa3, 0x0b, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x69,
 	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x06, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
@@ -59,39 +59,133 @@ var file_filial_service_proto_rawDesc = []byte{
 	0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x69, 0x61, 0x6c, 0x50, 0x4b, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6e, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x65,
+	0x61, 0x72, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x69, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x69, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x57,
+	0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57,
+	0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x60, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x72, 0x0a, 0x14,
+	0x53, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x5c, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x4b, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x83,
+	0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45,
+	0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x06, 0x49, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x23,
+	0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x73, 0x4f, 0x70, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1f, 0x5a,
+	0x1d, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_filial_service_proto_goTypes = []interface{}{
-	(*CreateFilial)(nil),          // 0: organization_service.CreateFilial
-	(*FilialPK)(nil),              // 1: organization_service.FilialPK
-	(*GetListFilialRequest)(nil),  // 2: organization_service.GetListFilialRequest
-	(*UpdateFilial)(nil),          // 3: organization_service.UpdateFilial
-	(*UpdatePatchFilial)(nil),     // 4: organization_service.UpdatePatchFilial
-	(*Filial)(nil),                // 5: organization_service.Filial
-	(*GetListFilialResponse)(nil), // 6: organization_service.GetListFilialResponse
-	(*empty.Empty)(nil),           // 7: google.protobuf.Empty
+	(*CreateFilial)(nil),                   // 0: organization_service.CreateFilial
+	(*FilialPK)(nil),                       // 1: organization_service.FilialPK
+	(*GetListFilialRequest)(nil),           // 2: organization_service.GetListFilialRequest
+	(*UpdateFilial)(nil),                   // 3: organization_service.UpdateFilial
+	(*UpdatePatchFilial)(nil),              // 4: organization_service.UpdatePatchFilial
+	(*PreviewFilialCodeRequest)(nil),       // 5: organization_service.PreviewFilialCodeRequest
+	(*FindNearestFilialsRequest)(nil),      // 6: organization_service.FindNearestFilialsRequest
+	(*SetWeeklyHoursRequest)(nil),          // 7: organization_service.SetWeeklyHoursRequest
+	(*GetWeeklyHoursRequest)(nil),          // 8: organization_service.GetWeeklyHoursRequest
+	(*SetScheduleExceptionRequest)(nil),    // 9: organization_service.SetScheduleExceptionRequest
+	(*ScheduleExceptionPK)(nil),            // 10: organization_service.ScheduleExceptionPK
+	(*ListScheduleExceptionsRequest)(nil),  // 11: organization_service.ListScheduleExceptionsRequest
+	(*IsOpenRequest)(nil),                  // 12: organization_service.IsOpenRequest
+	(*GetScheduleRequest)(nil),             // 13: organization_service.GetScheduleRequest
+	(*Filial)(nil),                         // 14: organization_service.Filial
+	(*GetListFilialResponse)(nil),          // 15: organization_service.GetListFilialResponse
+	(*empty.Empty)(nil),                    // 16: google.protobuf.Empty
+	(*PreviewFilialCodeResponse)(nil),      // 17: organization_service.PreviewFilialCodeResponse
+	(*FindNearestFilialsResponse)(nil),     // 18: organization_service.FindNearestFilialsResponse
+	(*WeeklyHours)(nil),                    // 19: organization_service.WeeklyHours
+	(*ScheduleException)(nil),              // 20: organization_service.ScheduleException
+	(*ListScheduleExceptionsResponse)(nil), // 21: organization_service.ListScheduleExceptionsResponse
+	(*IsOpenResponse)(nil),                 // 22: organization_service.IsOpenResponse
+	(*GetScheduleResponse)(nil),            // 23: organization_service.GetScheduleResponse
 }
 var file_filial_service_proto_depIdxs = []int32{
-	0, // 0: organization_service.FilialService.Create:input_type -> organization_service.CreateFilial
-	1, // 1: organization_service.FilialService.GetByID:input_type -> organization_service.FilialPK
-	2, // 2: organization_service.FilialService.GetList:input_type -> organization_service.GetListFilialRequest
-	3, // 3: organization_service.FilialService.Update:input_type -> organization_service.UpdateFilial
-	4, // 4: organization_service.FilialService.UpdatePatch:input_type -> organization_service.UpdatePatchFilial
-	1, // 5: organization_service.FilialService.Delete:input_type -> organization_service.FilialPK
-	5, // 6: organization_service.FilialService.Create:output_type -> organization_service.Filial
-	5, // 7: organization_service.FilialService.GetByID:output_type -> organization_service.Filial
-	6, // 8: organization_service.FilialService.GetList:output_type -> organization_service.GetListFilialResponse
-	5, // 9: organization_service.FilialService.Update:output_type -> organization_service.Filial
-	5, // 10: organization_service.FilialService.UpdatePatch:output_type -> organization_service.Filial
-	7, // 11: organization_service.FilialService.Delete:output_type -> google.protobuf.Empty
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: organization_service.FilialService.Create:input_type -> organization_service.CreateFilial
+	1,  // 1: organization_service.FilialService.GetByID:input_type -> organization_service.FilialPK
+	2,  // 2: organization_service.FilialService.GetList:input_type -> organization_service.GetListFilialRequest
+	3,  // 3: organization_service.FilialService.Update:input_type -> organization_service.UpdateFilial
+	4,  // 4: organization_service.FilialService.UpdatePatch:input_type -> organization_service.UpdatePatchFilial
+	1,  // 5: organization_service.FilialService.Delete:input_type -> organization_service.FilialPK
+	5,  // 6: organization_service.FilialService.PreviewCode:input_type -> organization_service.PreviewFilialCodeRequest
+	6,  // 7: organization_service.FilialService.FindNearest:input_type -> organization_service.FindNearestFilialsRequest
+	7,  // 8: organization_service.FilialService.SetWeeklyHours:input_type -> organization_service.SetWeeklyHoursRequest
+	8,  // 9: organization_service.FilialService.GetWeeklyHours:input_type -> organization_service.GetWeeklyHoursRequest
+	9,  // 10: organization_service.FilialService.SetScheduleException:input_type -> organization_service.SetScheduleExceptionRequest
+	10, // 11: organization_service.FilialService.RemoveScheduleException:input_type -> organization_service.ScheduleExceptionPK
+	11, // 12: organization_service.FilialService.ListScheduleExceptions:input_type -> organization_service.ListScheduleExceptionsRequest
+	12, // 13: organization_service.FilialService.IsOpen:input_type -> organization_service.IsOpenRequest
+	13, // 14: organization_service.FilialService.GetSchedule:input_type -> organization_service.GetScheduleRequest
+	14, // 15: organization_service.FilialService.Create:output_type -> organization_service.Filial
+	14, // 16: organization_service.FilialService.GetByID:output_type -> organization_service.Filial
+	15, // 17: organization_service.FilialService.GetList:output_type -> organization_service.GetListFilialResponse
+	14, // 18: organization_service.FilialService.Update:output_type -> organization_service.Filial
+	14, // 19: organization_service.FilialService.UpdatePatch:output_type -> organization_service.Filial
+	16, // 20: organization_service.FilialService.Delete:output_type -> google.protobuf.Empty
+	17, // 21: organization_service.FilialService.PreviewCode:output_type -> organization_service.PreviewFilialCodeResponse
+	18, // 22: organization_service.FilialService.FindNearest:output_type -> organization_service.FindNearestFilialsResponse
+	19, // 23: organization_service.FilialService.SetWeeklyHours:output_type -> organization_service.WeeklyHours
+	19, // 24: organization_service.FilialService.GetWeeklyHours:output_type -> organization_service.WeeklyHours
+	20, // 25: organization_service.FilialService.SetScheduleException:output_type -> organization_service.ScheduleException
+	16, // 26: organization_service.FilialService.RemoveScheduleException:output_type -> google.protobuf.Empty
+	21, // 27: organization_service.FilialService.ListScheduleExceptions:output_type -> organization_service.ListScheduleExceptionsResponse
+	22, // 28: organization_service.FilialService.IsOpen:output_type -> organization_service.IsOpenResponse
+	23, // 29: organization_service.FilialService.GetSchedule:output_type -> organization_service.GetScheduleResponse
+	15, // [15:30] is the sub-list for method output_type
+	0,  // [0:15] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_filial_service_proto_init() }
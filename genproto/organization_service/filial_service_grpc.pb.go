@@ -25,6 +25,15 @@ type FilialServiceClient interface {
 	Update(ctx context.Context, in *UpdateFilial, opts ...grpc.CallOption) (*Filial, error)
 	UpdatePatch(ctx context.Context, in *UpdatePatchFilial, opts ...grpc.CallOption) (*Filial, error)
 	Delete(ctx context.Context, in *FilialPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	PreviewCode(ctx context.Context, in *PreviewFilialCodeRequest, opts ...grpc.CallOption) (*PreviewFilialCodeResponse, error)
+	FindNearest(ctx context.Context, in *FindNearestFilialsRequest, opts ...grpc.CallOption) (*FindNearestFilialsResponse, error)
+	SetWeeklyHours(ctx context.Context, in *SetWeeklyHoursRequest, opts ...grpc.CallOption) (*WeeklyHours, error)
+	GetWeeklyHours(ctx context.Context, in *GetWeeklyHoursRequest, opts ...grpc.CallOption) (*WeeklyHours, error)
+	SetScheduleException(ctx context.Context, in *SetScheduleExceptionRequest, opts ...grpc.CallOption) (*ScheduleException, error)
+	RemoveScheduleException(ctx context.Context, in *ScheduleExceptionPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListScheduleExceptions(ctx context.Context, in *ListScheduleExceptionsRequest, opts ...grpc.CallOption) (*ListScheduleExceptionsResponse, error)
+	IsOpen(ctx context.Context, in *IsOpenRequest, opts ...grpc.CallOption) (*IsOpenResponse, error)
+	GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*GetScheduleResponse, error)
 }
 
 type filialServiceClient struct {
@@ -89,6 +98,87 @@ func (c *filialServiceClient) Delete(ctx context.Context, in *FilialPK, opts ...
 	return out, nil
 }
 
+func (c *filialServiceClient) PreviewCode(ctx context.Context, in *PreviewFilialCodeRequest, opts ...grpc.CallOption) (*PreviewFilialCodeResponse, error) {
+	out := new(PreviewFilialCodeResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/PreviewCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filialServiceClient) FindNearest(ctx context.Context, in *FindNearestFilialsRequest, opts ...grpc.CallOption) (*FindNearestFilialsResponse, error) {
+	out := new(FindNearestFilialsResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/FindNearest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filialServiceClient) SetWeeklyHours(ctx context.Context, in *SetWeeklyHoursRequest, opts ...grpc.CallOption) (*WeeklyHours, error) {
+	out := new(WeeklyHours)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/SetWeeklyHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filialServiceClient) GetWeeklyHours(ctx context.Context, in *GetWeeklyHoursRequest, opts ...grpc.CallOption) (*WeeklyHours, error) {
+	out := new(WeeklyHours)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/GetWeeklyHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filialServiceClient) SetScheduleException(ctx context.Context, in *SetScheduleExceptionRequest, opts ...grpc.CallOption) (*ScheduleException, error) {
+	out := new(ScheduleException)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/SetScheduleException", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filialServiceClient) RemoveScheduleException(ctx context.Context, in *ScheduleExceptionPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/RemoveScheduleException", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filialServiceClient) ListScheduleExceptions(ctx context.Context, in *ListScheduleExceptionsRequest, opts ...grpc.CallOption) (*ListScheduleExceptionsResponse, error) {
+	out := new(ListScheduleExceptionsResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/ListScheduleExceptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filialServiceClient) IsOpen(ctx context.Context, in *IsOpenRequest, opts ...grpc.CallOption) (*IsOpenResponse, error) {
+	out := new(IsOpenResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/IsOpen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filialServiceClient) GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*GetScheduleResponse, error) {
+	out := new(GetScheduleResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.FilialService/GetSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FilialServiceServer is the server API for FilialService service.
 // All implementations must embed UnimplementedFilialServiceServer
 // for forward compatibility
@@ -99,6 +189,15 @@ type FilialServiceServer interface {
 	Update(context.Context, *UpdateFilial) (*Filial, error)
 	UpdatePatch(context.Context, *UpdatePatchFilial) (*Filial, error)
 	Delete(context.Context, *FilialPK) (*empty.Empty, error)
+	PreviewCode(context.Context, *PreviewFilialCodeRequest) (*PreviewFilialCodeResponse, error)
+	FindNearest(context.Context, *FindNearestFilialsRequest) (*FindNearestFilialsResponse, error)
+	SetWeeklyHours(context.Context, *SetWeeklyHoursRequest) (*WeeklyHours, error)
+	GetWeeklyHours(context.Context, *GetWeeklyHoursRequest) (*WeeklyHours, error)
+	SetScheduleException(context.Context, *SetScheduleExceptionRequest) (*ScheduleException, error)
+	RemoveScheduleException(context.Context, *ScheduleExceptionPK) (*empty.Empty, error)
+	ListScheduleExceptions(context.Context, *ListScheduleExceptionsRequest) (*ListScheduleExceptionsResponse, error)
+	IsOpen(context.Context, *IsOpenRequest) (*IsOpenResponse, error)
+	GetSchedule(context.Context, *GetScheduleRequest) (*GetScheduleResponse, error)
 	mustEmbedUnimplementedFilialServiceServer()
 }
 
@@ -124,6 +223,33 @@ func (UnimplementedFilialServiceServer) UpdatePatch(context.Context, *UpdatePatc
 func (UnimplementedFilialServiceServer) Delete(context.Context, *FilialPK) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedFilialServiceServer) PreviewCode(context.Context, *PreviewFilialCodeRequest) (*PreviewFilialCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCode not implemented")
+}
+func (UnimplementedFilialServiceServer) FindNearest(context.Context, *FindNearestFilialsRequest) (*FindNearestFilialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearest not implemented")
+}
+func (UnimplementedFilialServiceServer) SetWeeklyHours(context.Context, *SetWeeklyHoursRequest) (*WeeklyHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWeeklyHours not implemented")
+}
+func (UnimplementedFilialServiceServer) GetWeeklyHours(context.Context, *GetWeeklyHoursRequest) (*WeeklyHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeeklyHours not implemented")
+}
+func (UnimplementedFilialServiceServer) SetScheduleException(context.Context, *SetScheduleExceptionRequest) (*ScheduleException, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScheduleException not implemented")
+}
+func (UnimplementedFilialServiceServer) RemoveScheduleException(context.Context, *ScheduleExceptionPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveScheduleException not implemented")
+}
+func (UnimplementedFilialServiceServer) ListScheduleExceptions(context.Context, *ListScheduleExceptionsRequest) (*ListScheduleExceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduleExceptions not implemented")
+}
+func (UnimplementedFilialServiceServer) IsOpen(context.Context, *IsOpenRequest) (*IsOpenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsOpen not implemented")
+}
+func (UnimplementedFilialServiceServer) GetSchedule(context.Context, *GetScheduleRequest) (*GetScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
 func (UnimplementedFilialServiceServer) mustEmbedUnimplementedFilialServiceServer() {}
 
 // UnsafeFilialServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -245,6 +371,168 @@ func _FilialService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FilialService_PreviewCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewFilialCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).PreviewCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/PreviewCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).PreviewCode(ctx, req.(*PreviewFilialCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilialService_FindNearest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearestFilialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).FindNearest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/FindNearest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).FindNearest(ctx, req.(*FindNearestFilialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilialService_SetWeeklyHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWeeklyHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).SetWeeklyHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/SetWeeklyHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).SetWeeklyHours(ctx, req.(*SetWeeklyHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilialService_GetWeeklyHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeeklyHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).GetWeeklyHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/GetWeeklyHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).GetWeeklyHours(ctx, req.(*GetWeeklyHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilialService_SetScheduleException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleExceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).SetScheduleException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/SetScheduleException",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).SetScheduleException(ctx, req.(*SetScheduleExceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilialService_RemoveScheduleException_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleExceptionPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).RemoveScheduleException(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/RemoveScheduleException",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).RemoveScheduleException(ctx, req.(*ScheduleExceptionPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilialService_ListScheduleExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduleExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).ListScheduleExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/ListScheduleExceptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).ListScheduleExceptions(ctx, req.(*ListScheduleExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilialService_IsOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).IsOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/IsOpen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).IsOpen(ctx, req.(*IsOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilialService_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilialServiceServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.FilialService/GetSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilialServiceServer).GetSchedule(ctx, req.(*GetScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FilialService_ServiceDesc is the grpc.ServiceDesc for FilialService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -276,6 +564,42 @@ var FilialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _FilialService_Delete_Handler,
 		},
+		{
+			MethodName: "PreviewCode",
+			Handler:    _FilialService_PreviewCode_Handler,
+		},
+		{
+			MethodName: "FindNearest",
+			Handler:    _FilialService_FindNearest_Handler,
+		},
+		{
+			MethodName: "SetWeeklyHours",
+			Handler:    _FilialService_SetWeeklyHours_Handler,
+		},
+		{
+			MethodName: "GetWeeklyHours",
+			Handler:    _FilialService_GetWeeklyHours_Handler,
+		},
+		{
+			MethodName: "SetScheduleException",
+			Handler:    _FilialService_SetScheduleException_Handler,
+		},
+		{
+			MethodName: "RemoveScheduleException",
+			Handler:    _FilialService_RemoveScheduleException_Handler,
+		},
+		{
+			MethodName: "ListScheduleExceptions",
+			Handler:    _FilialService_ListScheduleExceptions_Handler,
+		},
+		{
+			MethodName: "IsOpen",
+			Handler:    _FilialService_IsOpen_Handler,
+		},
+		{
+			MethodName: "GetSchedule",
+			Handler:    _FilialService_GetSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "filial_service.proto",
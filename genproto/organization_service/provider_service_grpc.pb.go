@@ -21,10 +21,32 @@ const _ = grpc.SupportPackageIsVersion7
 type ProviderServiceClient interface {
 	Create(ctx context.Context, in *CreateProvider, opts ...grpc.CallOption) (*Provider, error)
 	GetByID(ctx context.Context, in *ProviderPK, opts ...grpc.CallOption) (*Provider, error)
+	GetByPhone(ctx context.Context, in *ProviderPhoneRequest, opts ...grpc.CallOption) (*Provider, error)
 	GetList(ctx context.Context, in *GetListProviderRequest, opts ...grpc.CallOption) (*GetListProviderResponse, error)
 	Update(ctx context.Context, in *UpdateProvider, opts ...grpc.CallOption) (*Provider, error)
 	UpdatePatch(ctx context.Context, in *UpdatePatchProvider, opts ...grpc.CallOption) (*Provider, error)
 	Delete(ctx context.Context, in *ProviderPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	ChangeStatus(ctx context.Context, in *ChangeProviderStatusRequest, opts ...grpc.CallOption) (*Provider, error)
+	GetStatusHistory(ctx context.Context, in *GetProviderStatusHistoryRequest, opts ...grpc.CallOption) (*GetProviderStatusHistoryResponse, error)
+	AddContact(ctx context.Context, in *AddProviderContactRequest, opts ...grpc.CallOption) (*ProviderContact, error)
+	UpdateContact(ctx context.Context, in *UpdateProviderContactRequest, opts ...grpc.CallOption) (*ProviderContact, error)
+	RemoveContact(ctx context.Context, in *ProviderContactPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListContacts(ctx context.Context, in *ListProviderContactsRequest, opts ...grpc.CallOption) (*ListProviderContactsResponse, error)
+	Assign(ctx context.Context, in *AssignProviderRequest, opts ...grpc.CallOption) (*ProviderAssignment, error)
+	Unassign(ctx context.Context, in *UnassignProviderRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListMagazinProviders(ctx context.Context, in *ListMagazinProvidersRequest, opts ...grpc.CallOption) (*ListProviderAssignmentsResponse, error)
+	ListProviderMagazins(ctx context.Context, in *ListProviderMagazinsRequest, opts ...grpc.CallOption) (*ListProviderAssignmentsResponse, error)
+	AddProduct(ctx context.Context, in *AddProviderProductRequest, opts ...grpc.CallOption) (*ProviderProduct, error)
+	UpdateProduct(ctx context.Context, in *UpdateProviderProductRequest, opts ...grpc.CallOption) (*ProviderProduct, error)
+	RemoveProduct(ctx context.Context, in *ProviderProductPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListProducts(ctx context.Context, in *ListProviderProductsRequest, opts ...grpc.CallOption) (*ListProviderProductsResponse, error)
+	RecordDelivery(ctx context.Context, in *RecordProviderDeliveryRequest, opts ...grpc.CallOption) (*ProviderDelivery, error)
+	ListDeliveries(ctx context.Context, in *ListProviderDeliveriesRequest, opts ...grpc.CallOption) (*ListProviderDeliveriesResponse, error)
+	Rate(ctx context.Context, in *RateProviderRequest, opts ...grpc.CallOption) (*ProviderRating, error)
+	ListRatings(ctx context.Context, in *ListProviderRatingsRequest, opts ...grpc.CallOption) (*ListProviderRatingsResponse, error)
+	GetScorecard(ctx context.Context, in *GetProviderScorecardRequest, opts ...grpc.CallOption) (*ProviderScorecard, error)
+	FindDuplicates(ctx context.Context, in *FindProviderDuplicatesRequest, opts ...grpc.CallOption) (*FindProviderDuplicatesResponse, error)
+	Merge(ctx context.Context, in *MergeProvidersRequest, opts ...grpc.CallOption) (*Provider, error)
 }
 
 type providerServiceClient struct {
@@ -53,6 +75,15 @@ func (c *providerServiceClient) GetByID(ctx context.Context, in *ProviderPK, opt
 	return out, nil
 }
 
+func (c *providerServiceClient) GetByPhone(ctx context.Context, in *ProviderPhoneRequest, opts ...grpc.CallOption) (*Provider, error) {
+	out := new(Provider)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/GetByPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerServiceClient) GetList(ctx context.Context, in *GetListProviderRequest, opts ...grpc.CallOption) (*GetListProviderResponse, error) {
 	out := new(GetListProviderResponse)
 	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/GetList", in, out, opts...)
@@ -89,16 +120,227 @@ func (c *providerServiceClient) Delete(ctx context.Context, in *ProviderPK, opts
 	return out, nil
 }
 
+func (c *providerServiceClient) ChangeStatus(ctx context.Context, in *ChangeProviderStatusRequest, opts ...grpc.CallOption) (*Provider, error) {
+	out := new(Provider)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/ChangeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) GetStatusHistory(ctx context.Context, in *GetProviderStatusHistoryRequest, opts ...grpc.CallOption) (*GetProviderStatusHistoryResponse, error) {
+	out := new(GetProviderStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/GetStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) AddContact(ctx context.Context, in *AddProviderContactRequest, opts ...grpc.CallOption) (*ProviderContact, error) {
+	out := new(ProviderContact)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/AddContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) UpdateContact(ctx context.Context, in *UpdateProviderContactRequest, opts ...grpc.CallOption) (*ProviderContact, error) {
+	out := new(ProviderContact)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/UpdateContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) RemoveContact(ctx context.Context, in *ProviderContactPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/RemoveContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) ListContacts(ctx context.Context, in *ListProviderContactsRequest, opts ...grpc.CallOption) (*ListProviderContactsResponse, error) {
+	out := new(ListProviderContactsResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/ListContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) Assign(ctx context.Context, in *AssignProviderRequest, opts ...grpc.CallOption) (*ProviderAssignment, error) {
+	out := new(ProviderAssignment)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/Assign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) Unassign(ctx context.Context, in *UnassignProviderRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/Unassign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) ListMagazinProviders(ctx context.Context, in *ListMagazinProvidersRequest, opts ...grpc.CallOption) (*ListProviderAssignmentsResponse, error) {
+	out := new(ListProviderAssignmentsResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/ListMagazinProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) ListProviderMagazins(ctx context.Context, in *ListProviderMagazinsRequest, opts ...grpc.CallOption) (*ListProviderAssignmentsResponse, error) {
+	out := new(ListProviderAssignmentsResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/ListProviderMagazins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) AddProduct(ctx context.Context, in *AddProviderProductRequest, opts ...grpc.CallOption) (*ProviderProduct, error) {
+	out := new(ProviderProduct)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/AddProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) UpdateProduct(ctx context.Context, in *UpdateProviderProductRequest, opts ...grpc.CallOption) (*ProviderProduct, error) {
+	out := new(ProviderProduct)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/UpdateProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) RemoveProduct(ctx context.Context, in *ProviderProductPK, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/RemoveProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) ListProducts(ctx context.Context, in *ListProviderProductsRequest, opts ...grpc.CallOption) (*ListProviderProductsResponse, error) {
+	out := new(ListProviderProductsResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/ListProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) RecordDelivery(ctx context.Context, in *RecordProviderDeliveryRequest, opts ...grpc.CallOption) (*ProviderDelivery, error) {
+	out := new(ProviderDelivery)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/RecordDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) ListDeliveries(ctx context.Context, in *ListProviderDeliveriesRequest, opts ...grpc.CallOption) (*ListProviderDeliveriesResponse, error) {
+	out := new(ListProviderDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/ListDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) Rate(ctx context.Context, in *RateProviderRequest, opts ...grpc.CallOption) (*ProviderRating, error) {
+	out := new(ProviderRating)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/Rate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) ListRatings(ctx context.Context, in *ListProviderRatingsRequest, opts ...grpc.CallOption) (*ListProviderRatingsResponse, error) {
+	out := new(ListProviderRatingsResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/ListRatings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) GetScorecard(ctx context.Context, in *GetProviderScorecardRequest, opts ...grpc.CallOption) (*ProviderScorecard, error) {
+	out := new(ProviderScorecard)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/GetScorecard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) FindDuplicates(ctx context.Context, in *FindProviderDuplicatesRequest, opts ...grpc.CallOption) (*FindProviderDuplicatesResponse, error) {
+	out := new(FindProviderDuplicatesResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/FindDuplicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerServiceClient) Merge(ctx context.Context, in *MergeProvidersRequest, opts ...grpc.CallOption) (*Provider, error) {
+	out := new(Provider)
+	err := c.cc.Invoke(ctx, "/organization_service.ProviderService/Merge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProviderServiceServer is the server API for ProviderService service.
 // All implementations must embed UnimplementedProviderServiceServer
 // for forward compatibility
 type ProviderServiceServer interface {
 	Create(context.Context, *CreateProvider) (*Provider, error)
 	GetByID(context.Context, *ProviderPK) (*Provider, error)
+	GetByPhone(context.Context, *ProviderPhoneRequest) (*Provider, error)
 	GetList(context.Context, *GetListProviderRequest) (*GetListProviderResponse, error)
 	Update(context.Context, *UpdateProvider) (*Provider, error)
 	UpdatePatch(context.Context, *UpdatePatchProvider) (*Provider, error)
 	Delete(context.Context, *ProviderPK) (*empty.Empty, error)
+	ChangeStatus(context.Context, *ChangeProviderStatusRequest) (*Provider, error)
+	GetStatusHistory(context.Context, *GetProviderStatusHistoryRequest) (*GetProviderStatusHistoryResponse, error)
+	AddContact(context.Context, *AddProviderContactRequest) (*ProviderContact, error)
+	UpdateContact(context.Context, *UpdateProviderContactRequest) (*ProviderContact, error)
+	RemoveContact(context.Context, *ProviderContactPK) (*empty.Empty, error)
+	ListContacts(context.Context, *ListProviderContactsRequest) (*ListProviderContactsResponse, error)
+	Assign(context.Context, *AssignProviderRequest) (*ProviderAssignment, error)
+	Unassign(context.Context, *UnassignProviderRequest) (*empty.Empty, error)
+	ListMagazinProviders(context.Context, *ListMagazinProvidersRequest) (*ListProviderAssignmentsResponse, error)
+	ListProviderMagazins(context.Context, *ListProviderMagazinsRequest) (*ListProviderAssignmentsResponse, error)
+	AddProduct(context.Context, *AddProviderProductRequest) (*ProviderProduct, error)
+	UpdateProduct(context.Context, *UpdateProviderProductRequest) (*ProviderProduct, error)
+	RemoveProduct(context.Context, *ProviderProductPK) (*empty.Empty, error)
+	ListProducts(context.Context, *ListProviderProductsRequest) (*ListProviderProductsResponse, error)
+	RecordDelivery(context.Context, *RecordProviderDeliveryRequest) (*ProviderDelivery, error)
+	ListDeliveries(context.Context, *ListProviderDeliveriesRequest) (*ListProviderDeliveriesResponse, error)
+	Rate(context.Context, *RateProviderRequest) (*ProviderRating, error)
+	ListRatings(context.Context, *ListProviderRatingsRequest) (*ListProviderRatingsResponse, error)
+	GetScorecard(context.Context, *GetProviderScorecardRequest) (*ProviderScorecard, error)
+	FindDuplicates(context.Context, *FindProviderDuplicatesRequest) (*FindProviderDuplicatesResponse, error)
+	Merge(context.Context, *MergeProvidersRequest) (*Provider, error)
 	mustEmbedUnimplementedProviderServiceServer()
 }
 
@@ -112,6 +354,9 @@ func (UnimplementedProviderServiceServer) Create(context.Context, *CreateProvide
 func (UnimplementedProviderServiceServer) GetByID(context.Context, *ProviderPK) (*Provider, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
 }
+func (UnimplementedProviderServiceServer) GetByPhone(context.Context, *ProviderPhoneRequest) (*Provider, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByPhone not implemented")
+}
 func (UnimplementedProviderServiceServer) GetList(context.Context, *GetListProviderRequest) (*GetListProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
 }
@@ -124,6 +369,69 @@ func (UnimplementedProviderServiceServer) UpdatePatch(context.Context, *UpdatePa
 func (UnimplementedProviderServiceServer) Delete(context.Context, *ProviderPK) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedProviderServiceServer) ChangeStatus(context.Context, *ChangeProviderStatusRequest) (*Provider, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeStatus not implemented")
+}
+func (UnimplementedProviderServiceServer) GetStatusHistory(context.Context, *GetProviderStatusHistoryRequest) (*GetProviderStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusHistory not implemented")
+}
+func (UnimplementedProviderServiceServer) AddContact(context.Context, *AddProviderContactRequest) (*ProviderContact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContact not implemented")
+}
+func (UnimplementedProviderServiceServer) UpdateContact(context.Context, *UpdateProviderContactRequest) (*ProviderContact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContact not implemented")
+}
+func (UnimplementedProviderServiceServer) RemoveContact(context.Context, *ProviderContactPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContact not implemented")
+}
+func (UnimplementedProviderServiceServer) ListContacts(context.Context, *ListProviderContactsRequest) (*ListProviderContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (UnimplementedProviderServiceServer) Assign(context.Context, *AssignProviderRequest) (*ProviderAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Assign not implemented")
+}
+func (UnimplementedProviderServiceServer) Unassign(context.Context, *UnassignProviderRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unassign not implemented")
+}
+func (UnimplementedProviderServiceServer) ListMagazinProviders(context.Context, *ListMagazinProvidersRequest) (*ListProviderAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMagazinProviders not implemented")
+}
+func (UnimplementedProviderServiceServer) ListProviderMagazins(context.Context, *ListProviderMagazinsRequest) (*ListProviderAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviderMagazins not implemented")
+}
+func (UnimplementedProviderServiceServer) AddProduct(context.Context, *AddProviderProductRequest) (*ProviderProduct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProduct not implemented")
+}
+func (UnimplementedProviderServiceServer) UpdateProduct(context.Context, *UpdateProviderProductRequest) (*ProviderProduct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProviderServiceServer) RemoveProduct(context.Context, *ProviderProductPK) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProduct not implemented")
+}
+func (UnimplementedProviderServiceServer) ListProducts(context.Context, *ListProviderProductsRequest) (*ListProviderProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProviderServiceServer) RecordDelivery(context.Context, *RecordProviderDeliveryRequest) (*ProviderDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordDelivery not implemented")
+}
+func (UnimplementedProviderServiceServer) ListDeliveries(context.Context, *ListProviderDeliveriesRequest) (*ListProviderDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
+func (UnimplementedProviderServiceServer) Rate(context.Context, *RateProviderRequest) (*ProviderRating, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rate not implemented")
+}
+func (UnimplementedProviderServiceServer) ListRatings(context.Context, *ListProviderRatingsRequest) (*ListProviderRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRatings not implemented")
+}
+func (UnimplementedProviderServiceServer) GetScorecard(context.Context, *GetProviderScorecardRequest) (*ProviderScorecard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScorecard not implemented")
+}
+func (UnimplementedProviderServiceServer) FindDuplicates(context.Context, *FindProviderDuplicatesRequest) (*FindProviderDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedProviderServiceServer) Merge(context.Context, *MergeProvidersRequest) (*Provider, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Merge not implemented")
+}
 func (UnimplementedProviderServiceServer) mustEmbedUnimplementedProviderServiceServer() {}
 
 // UnsafeProviderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -173,6 +481,24 @@ func _ProviderService_GetByID_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProviderService_GetByPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).GetByPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/GetByPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).GetByPhone(ctx, req.(*ProviderPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProviderService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetListProviderRequest)
 	if err := dec(in); err != nil {
@@ -245,6 +571,384 @@ func _ProviderService_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProviderService_ChangeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeProviderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).ChangeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/ChangeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).ChangeStatus(ctx, req.(*ChangeProviderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_GetStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).GetStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/GetStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).GetStatusHistory(ctx, req.(*GetProviderStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_AddContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProviderContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).AddContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/AddContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).AddContact(ctx, req.(*AddProviderContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProviderContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).UpdateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/UpdateContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).UpdateContact(ctx, req.(*UpdateProviderContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_RemoveContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderContactPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).RemoveContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/RemoveContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).RemoveContact(ctx, req.(*ProviderContactPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/ListContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).ListContacts(ctx, req.(*ListProviderContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_Assign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).Assign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/Assign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).Assign(ctx, req.(*AssignProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_Unassign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).Unassign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/Unassign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).Unassign(ctx, req.(*UnassignProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_ListMagazinProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMagazinProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).ListMagazinProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/ListMagazinProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).ListMagazinProviders(ctx, req.(*ListMagazinProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_ListProviderMagazins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderMagazinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).ListProviderMagazins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/ListProviderMagazins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).ListProviderMagazins(ctx, req.(*ListProviderMagazinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_AddProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProviderProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).AddProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/AddProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).AddProduct(ctx, req.(*AddProviderProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProviderProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/UpdateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).UpdateProduct(ctx, req.(*UpdateProviderProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_RemoveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderProductPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).RemoveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/RemoveProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).RemoveProduct(ctx, req.(*ProviderProductPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/ListProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).ListProducts(ctx, req.(*ListProviderProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_RecordDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordProviderDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).RecordDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/RecordDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).RecordDelivery(ctx, req.(*RecordProviderDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/ListDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).ListDeliveries(ctx, req.(*ListProviderDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_Rate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).Rate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/Rate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).Rate(ctx, req.(*RateProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_ListRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProviderRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).ListRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/ListRatings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).ListRatings(ctx, req.(*ListProviderRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_GetScorecard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderScorecardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).GetScorecard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/GetScorecard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).GetScorecard(ctx, req.(*GetProviderScorecardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindProviderDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/FindDuplicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).FindDuplicates(ctx, req.(*FindProviderDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderService_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServiceServer).Merge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.ProviderService/Merge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServiceServer).Merge(ctx, req.(*MergeProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProviderService_ServiceDesc is the grpc.ServiceDesc for ProviderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -260,6 +964,10 @@ var ProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByID",
 			Handler:    _ProviderService_GetByID_Handler,
 		},
+		{
+			MethodName: "GetByPhone",
+			Handler:    _ProviderService_GetByPhone_Handler,
+		},
 		{
 			MethodName: "GetList",
 			Handler:    _ProviderService_GetList_Handler,
@@ -276,6 +984,90 @@ var ProviderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ProviderService_Delete_Handler,
 		},
+		{
+			MethodName: "ChangeStatus",
+			Handler:    _ProviderService_ChangeStatus_Handler,
+		},
+		{
+			MethodName: "GetStatusHistory",
+			Handler:    _ProviderService_GetStatusHistory_Handler,
+		},
+		{
+			MethodName: "AddContact",
+			Handler:    _ProviderService_AddContact_Handler,
+		},
+		{
+			MethodName: "UpdateContact",
+			Handler:    _ProviderService_UpdateContact_Handler,
+		},
+		{
+			MethodName: "RemoveContact",
+			Handler:    _ProviderService_RemoveContact_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _ProviderService_ListContacts_Handler,
+		},
+		{
+			MethodName: "Assign",
+			Handler:    _ProviderService_Assign_Handler,
+		},
+		{
+			MethodName: "Unassign",
+			Handler:    _ProviderService_Unassign_Handler,
+		},
+		{
+			MethodName: "ListMagazinProviders",
+			Handler:    _ProviderService_ListMagazinProviders_Handler,
+		},
+		{
+			MethodName: "ListProviderMagazins",
+			Handler:    _ProviderService_ListProviderMagazins_Handler,
+		},
+		{
+			MethodName: "AddProduct",
+			Handler:    _ProviderService_AddProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProviderService_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "RemoveProduct",
+			Handler:    _ProviderService_RemoveProduct_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _ProviderService_ListProducts_Handler,
+		},
+		{
+			MethodName: "RecordDelivery",
+			Handler:    _ProviderService_RecordDelivery_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _ProviderService_ListDeliveries_Handler,
+		},
+		{
+			MethodName: "Rate",
+			Handler:    _ProviderService_Rate_Handler,
+		},
+		{
+			MethodName: "ListRatings",
+			Handler:    _ProviderService_ListRatings_Handler,
+		},
+		{
+			MethodName: "GetScorecard",
+			Handler:    _ProviderService_GetScorecard_Handler,
+		},
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _ProviderService_FindDuplicates_Handler,
+		},
+		{
+			MethodName: "Merge",
+			Handler:    _ProviderService_Merge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provider_service.proto",
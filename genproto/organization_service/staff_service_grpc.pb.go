@@ -21,10 +21,19 @@ const _ = grpc.SupportPackageIsVersion7
 type StaffServiceClient interface {
 	Create(ctx context.Context, in *CreateStaff, opts ...grpc.CallOption) (*Staff, error)
 	GetByID(ctx context.Context, in *StaffPK, opts ...grpc.CallOption) (*Staff, error)
+	GetByPhone(ctx context.Context, in *StaffPhoneRequest, opts ...grpc.CallOption) (*Staff, error)
 	GetList(ctx context.Context, in *GetListStaffRequest, opts ...grpc.CallOption) (*GetListStaffResponse, error)
 	Update(ctx context.Context, in *UpdateStaff, opts ...grpc.CallOption) (*Staff, error)
 	UpdatePatch(ctx context.Context, in *UpdatePatchStaff, opts ...grpc.CallOption) (*Staff, error)
 	Delete(ctx context.Context, in *StaffPK, opts ...grpc.CallOption) (*empty.Empty, error)
+	Suspend(ctx context.Context, in *StaffStatusRequest, opts ...grpc.CallOption) (*Staff, error)
+	Reactivate(ctx context.Context, in *StaffStatusRequest, opts ...grpc.CallOption) (*Staff, error)
+	Terminate(ctx context.Context, in *StaffStatusRequest, opts ...grpc.CallOption) (*Staff, error)
+	Unlock(ctx context.Context, in *StaffPK, opts ...grpc.CallOption) (*Staff, error)
+	Login(ctx context.Context, in *StaffLoginRequest, opts ...grpc.CallOption) (*StaffLoginResponse, error)
+	ValidateToken(ctx context.Context, in *ValidateStaffTokenRequest, opts ...grpc.CallOption) (*ValidateStaffTokenResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type staffServiceClient struct {
@@ -53,6 +62,15 @@ func (c *staffServiceClient) GetByID(ctx context.Context, in *StaffPK, opts ...g
 	return out, nil
 }
 
+func (c *staffServiceClient) GetByPhone(ctx context.Context, in *StaffPhoneRequest, opts ...grpc.CallOption) (*Staff, error) {
+	out := new(Staff)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/GetByPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *staffServiceClient) GetList(ctx context.Context, in *GetListStaffRequest, opts ...grpc.CallOption) (*GetListStaffResponse, error) {
 	out := new(GetListStaffResponse)
 	err := c.cc.Invoke(ctx, "/organization_service.StaffService/GetList", in, out, opts...)
@@ -89,16 +107,97 @@ func (c *staffServiceClient) Delete(ctx context.Context, in *StaffPK, opts ...gr
 	return out, nil
 }
 
+func (c *staffServiceClient) Suspend(ctx context.Context, in *StaffStatusRequest, opts ...grpc.CallOption) (*Staff, error) {
+	out := new(Staff)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/Suspend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) Reactivate(ctx context.Context, in *StaffStatusRequest, opts ...grpc.CallOption) (*Staff, error) {
+	out := new(Staff)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/Reactivate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) Terminate(ctx context.Context, in *StaffStatusRequest, opts ...grpc.CallOption) (*Staff, error) {
+	out := new(Staff)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/Terminate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) Unlock(ctx context.Context, in *StaffPK, opts ...grpc.CallOption) (*Staff, error) {
+	out := new(Staff)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) Login(ctx context.Context, in *StaffLoginRequest, opts ...grpc.CallOption) (*StaffLoginResponse, error) {
+	out := new(StaffLoginResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ValidateToken(ctx context.Context, in *ValidateStaffTokenRequest, opts ...grpc.CallOption) (*ValidateStaffTokenResponse, error) {
+	out := new(ValidateStaffTokenResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/ValidateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/organization_service.StaffService/ConfirmPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StaffServiceServer is the server API for StaffService service.
 // All implementations must embed UnimplementedStaffServiceServer
 // for forward compatibility
 type StaffServiceServer interface {
 	Create(context.Context, *CreateStaff) (*Staff, error)
 	GetByID(context.Context, *StaffPK) (*Staff, error)
+	GetByPhone(context.Context, *StaffPhoneRequest) (*Staff, error)
 	GetList(context.Context, *GetListStaffRequest) (*GetListStaffResponse, error)
 	Update(context.Context, *UpdateStaff) (*Staff, error)
 	UpdatePatch(context.Context, *UpdatePatchStaff) (*Staff, error)
 	Delete(context.Context, *StaffPK) (*empty.Empty, error)
+	Suspend(context.Context, *StaffStatusRequest) (*Staff, error)
+	Reactivate(context.Context, *StaffStatusRequest) (*Staff, error)
+	Terminate(context.Context, *StaffStatusRequest) (*Staff, error)
+	Unlock(context.Context, *StaffPK) (*Staff, error)
+	Login(context.Context, *StaffLoginRequest) (*StaffLoginResponse, error)
+	ValidateToken(context.Context, *ValidateStaffTokenRequest) (*ValidateStaffTokenResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedStaffServiceServer()
 }
 
@@ -112,6 +211,9 @@ func (UnimplementedStaffServiceServer) Create(context.Context, *CreateStaff) (*S
 func (UnimplementedStaffServiceServer) GetByID(context.Context, *StaffPK) (*Staff, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByID not implemented")
 }
+func (UnimplementedStaffServiceServer) GetByPhone(context.Context, *StaffPhoneRequest) (*Staff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByPhone not implemented")
+}
 func (UnimplementedStaffServiceServer) GetList(context.Context, *GetListStaffRequest) (*GetListStaffResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
 }
@@ -124,6 +226,30 @@ func (UnimplementedStaffServiceServer) UpdatePatch(context.Context, *UpdatePatch
 func (UnimplementedStaffServiceServer) Delete(context.Context, *StaffPK) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedStaffServiceServer) Suspend(context.Context, *StaffStatusRequest) (*Staff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suspend not implemented")
+}
+func (UnimplementedStaffServiceServer) Reactivate(context.Context, *StaffStatusRequest) (*Staff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reactivate not implemented")
+}
+func (UnimplementedStaffServiceServer) Terminate(context.Context, *StaffStatusRequest) (*Staff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Terminate not implemented")
+}
+func (UnimplementedStaffServiceServer) Unlock(context.Context, *StaffPK) (*Staff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedStaffServiceServer) Login(context.Context, *StaffLoginRequest) (*StaffLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedStaffServiceServer) ValidateToken(context.Context, *ValidateStaffTokenRequest) (*ValidateStaffTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateToken not implemented")
+}
+func (UnimplementedStaffServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedStaffServiceServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
 func (UnimplementedStaffServiceServer) mustEmbedUnimplementedStaffServiceServer() {}
 
 // UnsafeStaffServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -173,6 +299,24 @@ func _StaffService_GetByID_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaffService_GetByPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).GetByPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/GetByPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).GetByPhone(ctx, req.(*StaffPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StaffService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetListStaffRequest)
 	if err := dec(in); err != nil {
@@ -245,6 +389,150 @@ func _StaffService_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StaffService_Suspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).Suspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/Suspend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).Suspend(ctx, req.(*StaffStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_Reactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).Reactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/Reactivate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).Reactivate(ctx, req.(*StaffStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_Terminate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).Terminate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/Terminate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).Terminate(ctx, req.(*StaffStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffPK)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).Unlock(ctx, req.(*StaffPK))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StaffLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).Login(ctx, req.(*StaffLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ValidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateStaffTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ValidateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/ValidateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ValidateToken(ctx, req.(*ValidateStaffTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/organization_service.StaffService/ConfirmPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StaffService_ServiceDesc is the grpc.ServiceDesc for StaffService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -260,6 +548,10 @@ var StaffService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByID",
 			Handler:    _StaffService_GetByID_Handler,
 		},
+		{
+			MethodName: "GetByPhone",
+			Handler:    _StaffService_GetByPhone_Handler,
+		},
 		{
 			MethodName: "GetList",
 			Handler:    _StaffService_GetList_Handler,
@@ -276,6 +568,38 @@ var StaffService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _StaffService_Delete_Handler,
 		},
+		{
+			MethodName: "Suspend",
+			Handler:    _StaffService_Suspend_Handler,
+		},
+		{
+			MethodName: "Reactivate",
+			Handler:    _StaffService_Reactivate_Handler,
+		},
+		{
+			MethodName: "Terminate",
+			Handler:    _StaffService_Terminate_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _StaffService_Unlock_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _StaffService_Login_Handler,
+		},
+		{
+			MethodName: "ValidateToken",
+			Handler:    _StaffService_ValidateToken_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _StaffService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _StaffService_ConfirmPasswordReset_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "staff_service.proto",
@@ -1,12 +1,47 @@
 package client
 
-import "organization_service/config"
+import (
+	"organization_service/config"
+	"organization_service/genproto/product_service"
 
-type ServiceManagerI interface{}
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
 
-type grpcClients struct{}
+type ServiceManagerI interface {
+	ProductService() product_service.ProductServiceClient
+	CategoryService() product_service.CategoryServiceClient
+}
+
+type grpcClients struct {
+	productService  product_service.ProductServiceClient
+	categoryService product_service.CategoryServiceClient
+}
 
 func NewGrpcClients(cfg config.Config) (ServiceManagerI, error) {
 
-	return &grpcClients{}, nil
+	// grpc.Dial does not block, so the service starts even while
+	// product_service is still unavailable.
+	connProductService, err := grpc.Dial(
+		cfg.ProductServiceHost+cfg.ProductServicePort,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &grpcClients{
+		productService:  product_service.NewProductServiceClient(connProductService),
+		categoryService: product_service.NewCategoryServiceClient(connProductService),
+	}, nil
+}
+
+func (g *grpcClients) ProductService() product_service.ProductServiceClient {
+	return g.productService
+}
+
+func (g *grpcClients) CategoryService() product_service.CategoryServiceClient {
+	return g.categoryService
 }
@@ -4,22 +4,49 @@ import (
 	"organization_service/config"
 	"organization_service/genproto/organization_service"
 	"organization_service/grpc/client"
+	"organization_service/grpc/interceptor"
 	"organization_service/grpc/service"
 	"organization_service/pkg/logger"
 	"organization_service/storage"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
 func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvc client.ServiceManagerI) (grpcServer *grpc.Server) {
 
-	grpcServer = grpc.NewServer()
+	trustedProxies, err := interceptor.ParseTrustedProxies(cfg.GRPCTrustedProxies)
+	if err != nil {
+		log.Panic("interceptor.ParseTrustedProxies", logger.Error(err))
+	}
+
+	grpcServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			otelgrpc.UnaryServerInterceptor(),
+			interceptor.UnaryRequestID(),
+			interceptor.UnaryClientIP(trustedProxies),
+			interceptor.UnaryMetrics(),
+			interceptor.UnaryLogging(log),
+			interceptor.UnaryRecovery(log),
+			interceptor.UnaryValidation(),
+		),
+		grpc.ChainStreamInterceptor(
+			otelgrpc.StreamServerInterceptor(),
+			interceptor.StreamRequestID(),
+			interceptor.StreamClientIP(trustedProxies),
+			interceptor.StreamMetrics(),
+			interceptor.StreamLogging(log),
+			interceptor.StreamRecovery(log),
+		),
+	)
 
 	organization_service.RegisterFilialServiceServer(grpcServer, service.NewFilialService(cfg, log, strg, srvc))
 	organization_service.RegisterMagazinServiceServer(grpcServer, service.NewMagazinService(cfg, log, strg, srvc))
 	organization_service.RegisterProviderServiceServer(grpcServer, service.NewProviderService(cfg, log, strg, srvc))
 	organization_service.RegisterStaffServiceServer(grpcServer, service.NewStaffService(cfg, log, strg, srvc))
+	organization_service.RegisterPurchaseOrderServiceServer(grpcServer, service.NewPurchaseOrderService(cfg, log, strg, srvc))
+	organization_service.RegisterWebhookServiceServer(grpcServer, service.NewWebhookService(cfg, log, strg, srvc))
 
 	reflection.Register(grpcServer)
 	return
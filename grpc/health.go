@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"organization_service/config"
+	"organization_service/pkg/logger"
+	"organization_service/storage"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthPostgres is the health service name reporting the database status.
+const HealthPostgres = "postgres"
+
+// HealthChecker keeps the grpc.health.v1 statuses of the server up to date.
+// The overall status ("") and the status of every registered service follow
+// the status of their dependencies.
+type HealthChecker struct {
+	server   *health.Server
+	strg     storage.StorageI
+	log      logger.LoggerI
+	interval time.Duration
+	services []string
+}
+
+// SetUpHealth registers the health service on grpcServer. Everything starts
+// as NOT_SERVING until the first successful dependency check.
+func SetUpHealth(cfg config.Config, log logger.LoggerI, grpcServer *grpc.Server, strg storage.StorageI) *HealthChecker {
+	h := &HealthChecker{
+		server:   health.NewServer(),
+		strg:     strg,
+		log:      log,
+		interval: cfg.HealthCheckInterval,
+		services: []string{""},
+	}
+
+	for name := range grpcServer.GetServiceInfo() {
+		h.services = append(h.services, name)
+	}
+
+	h.setServing(false)
+	h.server.SetServingStatus(HealthPostgres, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+
+	grpc_health_v1.RegisterHealthServer(grpcServer, h.server)
+
+	return h
+}
+
+// Run checks the dependencies every interval until ctx is cancelled.
+func (h *HealthChecker) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		h.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown reports NOT_SERVING for every service and ignores later checks,
+// so that load balancers stop routing new calls while the server drains.
+func (h *HealthChecker) Shutdown() {
+	h.server.Shutdown()
+}
+
+func (h *HealthChecker) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, h.interval)
+	defer cancel()
+
+	postgres := grpc_health_v1.HealthCheckResponse_SERVING
+
+	err := h.strg.Ping(ctx)
+	if err != nil {
+		h.log.Error("!!!HealthCheck->Postgres->Ping--->", logger.Error(err))
+		postgres = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	h.server.SetServingStatus(HealthPostgres, postgres)
+	h.setServing(err == nil)
+}
+
+func (h *HealthChecker) setServing(serving bool) {
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+
+	for _, name := range h.services {
+		h.server.SetServingStatus(name, status)
+	}
+}
@@ -0,0 +1,117 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientIPKey is the metadata key the REST gateway forwards the address of
+// its client with.
+const ClientIPKey = "x-forwarded-for"
+
+type clientIPCtxKey struct{}
+
+// ClientIP returns the address of the client of the call handled with ctx,
+// as resolved by UnaryClientIP, or the address of the peer.
+func ClientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPCtxKey{}).(string); ok {
+		return ip
+	}
+
+	return peerIP(ctx)
+}
+
+// ParseTrustedProxies parses the addresses and CIDR ranges of the proxies
+// trusted to forward the address of their client.
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("trusted proxy %q is not an IP address or a CIDR range", proxy)
+			}
+			if ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is not an IP address or a CIDR range", proxy)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+func UnaryClientIP(trusted []*net.IPNet) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withClientIP(ctx, trusted), req)
+	}
+}
+
+func StreamClientIP(trusted []*net.IPNet) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: withClientIP(ss.Context(), trusted)})
+	}
+}
+
+// withClientIP stores the address of the client in the context: the first
+// address forwarded in the metadata when the peer is a trusted proxy, as the
+// REST gateway, the address of the peer otherwise.
+func withClientIP(ctx context.Context, trusted []*net.IPNet) context.Context {
+	ip := peerIP(ctx)
+
+	if isTrusted(ip, trusted) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(ClientIPKey); len(values) > 0 {
+				if forwarded := strings.TrimSpace(strings.Split(values[0], ",")[0]); net.ParseIP(forwarded) != nil {
+					ip = forwarded
+				}
+			}
+		}
+	}
+
+	return context.WithValue(ctx, clientIPCtxKey{}, ip)
+}
+
+func isTrusted(ip string, trusted []*net.IPNet) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	for _, network := range trusted {
+		if network.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
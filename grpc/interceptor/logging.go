@@ -0,0 +1,81 @@
+package interceptor
+
+import (
+	"context"
+	"organization_service/pkg/logger"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// requestLogger returns log with the fields identifying the call in ctx.
+func requestLogger(ctx context.Context, log logger.LoggerI, method string) logger.LoggerI {
+	return logger.WithTrace(ctx, log).With(
+		logger.String("method", method),
+		logger.String("request_id", RequestID(ctx)),
+	)
+}
+
+func logCall(ctx context.Context, log logger.LoggerI, start time.Time, req interface{}, err error) {
+	code := status.Code(err)
+
+	fields := []logger.Field{
+		logger.String("code", code.String()),
+		logger.String("duration", time.Since(start).String()),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, logger.String("peer", p.Addr.String()))
+	}
+	if req != nil {
+		fields = append(fields, logger.Any("req", req))
+	}
+	if err != nil {
+		fields = append(fields, logger.Error(err))
+	}
+
+	switch code {
+	case codes.OK:
+		log.Info("GRPC: call finished", fields...)
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		log.Error("GRPC: call failed", fields...)
+	default:
+		log.Warn("GRPC: call failed", fields...)
+	}
+}
+
+// UnaryLogging stores a request scoped logger in the context of the call,
+// see logger.FromContext, and writes one access log entry per call.
+func UnaryLogging(log logger.LoggerI) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		reqLog := requestLogger(ctx, log, info.FullMethod)
+		ctx = logger.NewContext(ctx, reqLog)
+
+		resp, err := handler(ctx, req)
+
+		logCall(ctx, reqLog, start, req, err)
+
+		return resp, err
+	}
+}
+
+// StreamLogging stores a request scoped logger in the context of the stream
+// and writes one access log entry per stream when it ends.
+func StreamLogging(log logger.LoggerI) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		reqLog := requestLogger(ss.Context(), log, info.FullMethod)
+		ctx := logger.NewContext(ss.Context(), reqLog)
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+
+		logCall(ctx, reqLog, start, nil, err)
+
+		return err
+	}
+}
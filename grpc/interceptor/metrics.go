@@ -0,0 +1,41 @@
+package interceptor
+
+import (
+	"context"
+	"organization_service/pkg/metrics"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func observeCall(method string, start time.Time, err error) {
+	metrics.RPCHandled.WithLabelValues(method, status.Code(err).String()).Inc()
+	metrics.RPCDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// UnaryMetrics records the call count and latency of every unary call.
+func UnaryMetrics() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		observeCall(info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamMetrics records the call count and duration of every stream.
+func StreamMetrics() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		observeCall(info.FullMethod, start, err)
+
+		return err
+	}
+}
@@ -0,0 +1,43 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"organization_service/pkg/logger"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func recoverPanic(ctx context.Context, log logger.LoggerI, method string, err *error) {
+	if r := recover(); r != nil {
+		logger.WithTrace(ctx, log).Error("!!!Panic recovered--->",
+			logger.String("method", method),
+			logger.String("request_id", RequestID(ctx)),
+			logger.Any("panic", fmt.Sprint(r)),
+			logger.String("stack", string(debug.Stack())),
+		)
+
+		*err = status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// UnaryRecovery converts panics of the handler into codes.Internal errors.
+func UnaryRecovery(log logger.LoggerI) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer recoverPanic(ctx, log, info.FullMethod, &err)
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecovery converts panics of the handler into codes.Internal errors.
+func StreamRecovery(log logger.LoggerI) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer recoverPanic(ss.Context(), log, info.FullMethod, &err)
+
+		return handler(srv, ss)
+	}
+}
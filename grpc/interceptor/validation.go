@@ -0,0 +1,20 @@
+package interceptor
+
+import (
+	"context"
+	"organization_service/pkg/validation"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryValidation rejects requests breaking the rules of the validation
+// package before they reach the handler.
+func UnaryValidation() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validation.Validate(req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"organization_service/pkg/auth"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// actorID returns the id of the staff making the call, taken from the bearer
+// token in the authorization metadata. It is empty if the call carries no
+// valid token.
+func actorID(ctx context.Context, tokens *auth.TokenManager) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+
+	token := strings.TrimSpace(values[0])
+	if len(token) > len("bearer ") && strings.EqualFold(token[:len("bearer ")], "bearer ") {
+		token = token[len("bearer "):]
+	}
+
+	claims, err := tokens.Parse(token)
+	if err != nil {
+		return ""
+	}
+
+	return claims.Subject
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"errors"
+	"organization_service/storage"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storageError converts an error returned by the storage into a gRPC status
+// error. Errors the storage doesn't classify get code, status errors are
+// returned as is.
+func storageError(err error, code codes.Code) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, storage.ErrAlreadyExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, storage.ErrReferenceViolation):
+		code = codes.FailedPrecondition
+	case errors.Is(err, storage.ErrInvalidValue):
+		code = codes.InvalidArgument
+	}
+
+	return status.Error(code, err.Error())
+}
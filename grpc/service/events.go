@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"organization_service/genproto/organization_service"
+	"organization_service/pkg/logger"
+	"organization_service/pkg/webhook"
+	"organization_service/storage"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// publishEvent queues webhook deliveries of an event for all matching
+// subscriptions. Failing to queue is logged and never fails the calling RPC.
+func publishEvent(ctx context.Context, strg storage.StorageI, eventType, filialID string, data proto.Message) {
+	log := logger.FromContext(ctx)
+
+	payload, err := webhook.NewPayload(eventType, filialID, data)
+	if err != nil {
+		log.Error("!!!publishEvent->NewPayload--->", logger.Error(err), logger.String("event", eventType))
+		return
+	}
+
+	if _, err = strg.Webhook().Enqueue(ctx, eventType, filialID, payload); err != nil {
+		log.Error("!!!publishEvent->Webhook->Enqueue--->", logger.Error(err), logger.String("event", eventType))
+	}
+}
+
+// magazinFilialID returns the filial a magazin belongs to, or an empty string
+// if the magazin can't be loaded.
+func magazinFilialID(ctx context.Context, strg storage.StorageI, magazinID string) string {
+	magazin, err := strg.Magazin().GetByID(ctx, &organization_service.MagazinPK{Id: magazinID})
+	if err != nil {
+		return ""
+	}
+
+	return magazin.FilialId
+}
@@ -0,0 +1,376 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"organization_service/genproto/organization_service"
+	"organization_service/pkg/logger"
+	"organization_service/pkg/schedule"
+	"organization_service/pkg/webhook"
+	"organization_service/storage"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetWeeklyHours replaces the weekly hours of a filial or a magazin.
+func (i *FilialService) SetWeeklyHours(ctx context.Context, req *organization_service.SetWeeklyHoursRequest) (resp *organization_service.WeeklyHours, err error) {
+
+	filialID, err := i.scheduleOwnerFilialID(ctx, req.FilialId, req.MagazinId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.strg.Schedule().ReplaceWeeklyHours(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!SetWeeklyHours->Schedule->ReplaceWeeklyHours--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	resp, err = i.GetWeeklyHours(ctx, &organization_service.GetWeeklyHoursRequest{
+		FilialId:  req.FilialId,
+		MagazinId: req.MagazinId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventFilialScheduleUpdated, filialID, resp)
+
+	return resp, nil
+}
+
+// GetWeeklyHours returns the weekly hours of a filial, or of a magazin,
+// which are those of its filial if it has none of its own.
+func (i *FilialService) GetWeeklyHours(ctx context.Context, req *organization_service.GetWeeklyHoursRequest) (resp *organization_service.WeeklyHours, err error) {
+
+	filialID, err := i.scheduleOwnerFilialID(ctx, req.FilialId, req.MagazinId)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.weeklyHours(ctx, filialID, req.MagazinId)
+}
+
+// SetScheduleException sets the hours of a filial or a magazin on a date,
+// replacing the exception the date already had.
+func (i *FilialService) SetScheduleException(ctx context.Context, req *organization_service.SetScheduleExceptionRequest) (resp *organization_service.ScheduleException, err error) {
+
+	filialID, err := i.scheduleOwnerFilialID(ctx, req.FilialId, req.MagazinId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = i.strg.Schedule().UpsertException(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!SetScheduleException->Schedule->UpsertException--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventFilialScheduleUpdated, filialID, resp)
+
+	return resp, nil
+}
+
+func (i *FilialService) RemoveScheduleException(ctx context.Context, req *organization_service.ScheduleExceptionPK) (resp *empty.Empty, err error) {
+
+	exception, err := i.strg.Schedule().DeleteException(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RemoveScheduleException->Schedule->DeleteException--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	filialID := exception.FilialId
+	if exception.MagazinId != "" {
+		filialID = magazinFilialID(ctx, i.strg, exception.MagazinId)
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventFilialScheduleUpdated, filialID, exception)
+
+	return &empty.Empty{}, nil
+}
+
+func (i *FilialService) ListScheduleExceptions(ctx context.Context, req *organization_service.ListScheduleExceptionsRequest) (resp *organization_service.ListScheduleExceptionsResponse, err error) {
+
+	resp, err = i.strg.Schedule().GetExceptionList(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ListScheduleExceptions->Schedule->GetExceptionList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+// IsOpen reports whether the filial or the magazin req.Id is open at req.At,
+// in the time zone of the filial.
+func (i *FilialService) IsOpen(ctx context.Context, req *organization_service.IsOpenRequest) (resp *organization_service.IsOpenResponse, err error) {
+
+	at := time.Now()
+	if req.At != "" {
+		if at, err = time.Parse(time.RFC3339, req.At); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "at must be an RFC 3339 timestamp")
+		}
+	}
+
+	owner, err := i.scheduleOwner(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the exceptions of the day before and after cover any offset between
+	// the time zone of at and that of the filial
+	from := at.AddDate(0, 0, -1).Format(schedule.DateLayout)
+	to := at.AddDate(0, 0, 1).Format(schedule.DateLayout)
+
+	sched, err := i.loadSchedule(ctx, owner, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	open, interval, day := sched.IsOpen(at)
+
+	resp = &organization_service.IsOpenResponse{
+		Open:      open,
+		FilialId:  owner.filial.Id,
+		MagazinId: owner.magazinID,
+		TimeZone:  sched.Location.String(),
+		LocalTime: at.In(sched.Location).Format(time.RFC3339),
+		Reason:    day.Reason,
+	}
+	if open {
+		resp.Hours = timeInterval(interval)
+	}
+
+	return resp, nil
+}
+
+// GetSchedule returns the effective hours of the filial or the magazin
+// req.Id on each date from req.DateFrom to req.DateTo.
+func (i *FilialService) GetSchedule(ctx context.Context, req *organization_service.GetScheduleRequest) (resp *organization_service.GetScheduleResponse, err error) {
+
+	from, err := time.Parse(schedule.DateLayout, req.DateFrom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "date_from must be a YYYY-MM-DD date")
+	}
+	to, err := time.Parse(schedule.DateLayout, req.DateTo)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "date_to must be a YYYY-MM-DD date")
+	}
+
+	owner, err := i.scheduleOwner(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := i.loadSchedule(ctx, owner, req.DateFrom, req.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &organization_service.GetScheduleResponse{
+		FilialId:  owner.filial.Id,
+		MagazinId: owner.magazinID,
+		TimeZone:  sched.Location.String(),
+	}
+
+	for _, day := range sched.Days(from, to) {
+		scheduleDay := &organization_service.ScheduleDay{
+			Date:      day.Date,
+			Weekday:   int32(day.Weekday),
+			Closed:    day.Closed(),
+			Exception: day.Exception,
+			Reason:    day.Reason,
+		}
+		for _, interval := range day.Hours {
+			scheduleDay.Hours = append(scheduleDay.Hours, timeInterval(interval))
+		}
+
+		resp.Days = append(resp.Days, scheduleDay)
+	}
+
+	return resp, nil
+}
+
+// scheduleOwnerInfo is the filial or the magazin a schedule is asked for,
+// with the filial giving the time zone.
+type scheduleOwnerInfo struct {
+	filial    *organization_service.Filial
+	magazinID string
+}
+
+// scheduleOwner resolves id as a filial or else as a magazin.
+func (i *FilialService) scheduleOwner(ctx context.Context, id string) (*scheduleOwnerInfo, error) {
+	filial, err := i.strg.Filial().GetByID(ctx, &organization_service.FilialPK{Id: id})
+	if err == nil {
+		return &scheduleOwnerInfo{filial: filial}, nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		logger.FromContext(ctx).Error("!!!ScheduleOwner->Filial->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	magazin, err := i.strg.Magazin().GetByID(ctx, &organization_service.MagazinPK{Id: id})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "no filial or magazin with this id")
+		}
+		logger.FromContext(ctx).Error("!!!ScheduleOwner->Magazin->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	filial, err = i.strg.Filial().GetByID(ctx, &organization_service.FilialPK{Id: magazin.FilialId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ScheduleOwner->Filial->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	return &scheduleOwnerInfo{filial: filial, magazinID: magazin.Id}, nil
+}
+
+// scheduleOwnerFilialID checks that the filial or the magazin exists and
+// returns the id of the filial.
+func (i *FilialService) scheduleOwnerFilialID(ctx context.Context, filialID, magazinID string) (string, error) {
+	if magazinID == "" {
+		if _, err := i.strg.Filial().GetByID(ctx, &organization_service.FilialPK{Id: filialID}); err != nil {
+			logger.FromContext(ctx).Error("!!!ScheduleOwner->Filial->Get--->", logger.Error(err))
+			return "", storageError(err, codes.NotFound)
+		}
+		return filialID, nil
+	}
+
+	magazin, err := i.strg.Magazin().GetByID(ctx, &organization_service.MagazinPK{Id: magazinID})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ScheduleOwner->Magazin->Get--->", logger.Error(err))
+		return "", storageError(err, codes.NotFound)
+	}
+
+	return magazin.FilialId, nil
+}
+
+// loadSchedule loads the weekly hours of the owner and its exceptions from
+// from to to. A magazin without weekly hours follows its filial, and its
+// exceptions take precedence over those of the filial.
+func (i *FilialService) loadSchedule(ctx context.Context, owner *scheduleOwnerInfo, from, to string) (*schedule.Schedule, error) {
+	// filials created before time zones have none until it is backfilled,
+	// and time.LoadLocation would take an empty zone for UTC
+	timeZone := owner.filial.TimeZone
+	if timeZone == "" {
+		timeZone = i.cfg.FilialDefaultTimeZone
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!LoadSchedule->LoadLocation--->", logger.Error(err))
+		return nil, status.Errorf(codes.Internal, "filial has an invalid time zone %q", timeZone)
+	}
+
+	weekly, err := i.weeklyHours(ctx, owner.filial.Id, owner.magazinID)
+	if err != nil {
+		return nil, err
+	}
+
+	sched := &schedule.Schedule{
+		Location:   location,
+		Exceptions: make(map[string]schedule.Exception),
+	}
+
+	for _, hours := range weekly.Hours {
+		interval, err := schedule.ParseInterval(hours.OpensAt, hours.ClosesAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid working hours: %s", err)
+		}
+		sched.Week[hours.Weekday] = append(sched.Week[hours.Weekday], interval)
+	}
+
+	// the exceptions of the magazin are applied last, over those of the filial
+	owners := []*organization_service.ListScheduleExceptionsRequest{{FilialId: owner.filial.Id}}
+	if owner.magazinID != "" {
+		owners = append(owners, &organization_service.ListScheduleExceptionsRequest{MagazinId: owner.magazinID})
+	}
+
+	for _, req := range owners {
+		req.DateFrom, req.DateTo = from, to
+
+		exceptions, err := i.strg.Schedule().GetExceptionList(ctx, req)
+		if err != nil {
+			logger.FromContext(ctx).Error("!!!LoadSchedule->Schedule->GetExceptionList--->", logger.Error(err))
+			return nil, storageError(err, codes.Internal)
+		}
+
+		for _, exception := range exceptions.Exceptions {
+			day := schedule.Exception{Closed: exception.Closed, Reason: exception.Reason}
+			if !exception.Closed {
+				interval, err := schedule.ParseInterval(exception.OpensAt, exception.ClosesAt)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "invalid schedule exception: %s", err)
+				}
+				day.Hours = []schedule.Interval{interval}
+			}
+			sched.Exceptions[exception.Date] = day
+		}
+	}
+
+	return sched, nil
+}
+
+// weeklyHours returns the weekly hours of the filial, or of the magazin of
+// the filial if magazinID is set. A magazin without hours of its own
+// inherits those of the filial.
+func (i *FilialService) weeklyHours(ctx context.Context, filialID, magazinID string) (*organization_service.WeeklyHours, error) {
+	resp := &organization_service.WeeklyHours{
+		FilialId:  filialID,
+		MagazinId: magazinID,
+	}
+
+	owner := &organization_service.GetWeeklyHoursRequest{FilialId: filialID}
+	if magazinID != "" {
+		owner = &organization_service.GetWeeklyHoursRequest{MagazinId: magazinID}
+	}
+
+	hours, err := i.strg.Schedule().GetWeeklyHours(ctx, owner)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!WeeklyHours->Schedule->GetWeeklyHours--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if magazinID != "" && len(hours) == 0 {
+		resp.Inherited = true
+		hours, err = i.strg.Schedule().GetWeeklyHours(ctx, &organization_service.GetWeeklyHoursRequest{FilialId: filialID})
+		if err != nil {
+			logger.FromContext(ctx).Error("!!!WeeklyHours->Schedule->GetWeeklyHours--->", logger.Error(err))
+			return nil, storageError(err, codes.Internal)
+		}
+	}
+
+	resp.Hours = hours
+
+	return resp, nil
+}
+
+func timeInterval(interval schedule.Interval) *organization_service.TimeInterval {
+	return &organization_service.TimeInterval{
+		OpensAt:  schedule.FormatClock(interval.Opens),
+		ClosesAt: schedule.FormatClock(interval.Closes),
+	}
+}
+
+// checkPatchTimeZone checks the time zone of UpdatePatch fields, if set.
+func checkPatchTimeZone(fields map[string]interface{}) error {
+	value, ok := fields["time_zone"]
+	if !ok {
+		return nil
+	}
+
+	zone, ok := value.(string)
+	if !ok || zone == "" || zone == "Local" {
+		return status.Error(codes.InvalidArgument, "time_zone must be an IANA time zone, as Asia/Tashkent")
+	}
+
+	if _, err := time.LoadLocation(zone); err != nil {
+		return status.Error(codes.InvalidArgument, "time_zone must be an IANA time zone, as Asia/Tashkent")
+	}
+
+	return nil
+}
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"organization_service/config"
 	"organization_service/genproto/organization_service"
 	"organization_service/grpc/client"
 	"organization_service/models"
+	"organization_service/pkg/filialcode"
 	"organization_service/pkg/logger"
+	"organization_service/pkg/webhook"
 	"organization_service/storage"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -19,45 +22,80 @@ type FilialService struct {
 	log      logger.LoggerI
 	strg     storage.StorageI
 	services client.ServiceManagerI
+	codes    filialcode.Generator
 	*organization_service.UnimplementedFilialServiceServer
 }
 
+// Defaults of FindNearest requests.
+const (
+	defaultNearestRadius = 5000
+	defaultNearestLimit  = 10
+)
+
+// maxFilialCodeAttempts bounds how many generated codes Create tries when
+// they are already taken by explicitly set ones.
+const maxFilialCodeAttempts = 5
+
 func NewFilialService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *FilialService {
+	codes, err := filialcode.NewGenerator(cfg)
+	if err != nil {
+		log.Panic("filialcode.NewGenerator", logger.Error(err))
+	}
+
 	return &FilialService{
 		cfg:      cfg,
 		log:      log,
 		strg:     strg,
 		services: srvs,
+		codes:    codes,
 	}
 }
 
 func (i *FilialService) Create(ctx context.Context, req *organization_service.CreateFilial) (resp *organization_service.Filial, err error) {
 
-	i.log.Info("---CreateFilial------>", logger.Any("req", req))
+	if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+		return nil, err
+	}
+
+	var pKey *organization_service.FilialPK
+
+	req.Region = filialcode.NormalizeRegion(req.Region)
+	if req.TimeZone == "" {
+		req.TimeZone = i.cfg.FilialDefaultTimeZone
+	}
+
+	if req.FilialCode != "" {
+		req.FilialCode = filialcode.Normalize(req.FilialCode)
+		if err = filialcode.Validate(req.FilialCode); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 
-	pKey, err := i.strg.Filial().Create(ctx, req)
+		pKey, err = i.strg.Filial().Create(ctx, req)
+	} else {
+		pKey, err = i.createWithGeneratedCode(ctx, req)
+	}
 	if err != nil {
-		i.log.Error("!!!CreateFilial->Filial->Create--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!CreateFilial->Filial->Create--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	resp, err = i.strg.Filial().GetByID(ctx, pKey)
 	if err != nil {
-		i.log.Error("!!!GetByPKeyFilial->Filial->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetByPKeyFilial->Filial->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventFilialCreated, resp.Id, resp)
+
 	return
 }
 
 func (i *FilialService) GetByID(ctx context.Context, req *organization_service.FilialPK) (resp *organization_service.Filial, err error) {
 
-	i.log.Info("---GetFilialByID------>", logger.Any("req", req))
-
 	resp, err = i.strg.Filial().GetByID(ctx, req)
 	if err != nil {
-		i.log.Error("!!!GetFilialByID->Filial->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetFilialByID->Filial->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	return
@@ -65,12 +103,10 @@ func (i *FilialService) GetByID(ctx context.Context, req *organization_service.F
 
 func (i *FilialService) GetList(ctx context.Context, req *organization_service.GetListFilialRequest) (resp *organization_service.GetListFilialResponse, err error) {
 
-	i.log.Info("---GetFilials------>", logger.Any("req", req))
-
 	resp, err = i.strg.Filial().GetList(ctx, req)
 	if err != nil {
-		i.log.Error("!!!GetFilials->Filial->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetFilials->Filial->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	return
@@ -78,13 +114,21 @@ func (i *FilialService) GetList(ctx context.Context, req *organization_service.G
 
 func (i *FilialService) Update(ctx context.Context, req *organization_service.UpdateFilial) (resp *organization_service.Filial, err error) {
 
-	i.log.Info("---UpdateFilial------>", logger.Any("req", req))
+	if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+		return nil, err
+	}
+
+	req.Region = filialcode.NormalizeRegion(req.Region)
+	req.FilialCode = filialcode.Normalize(req.FilialCode)
+	if err = filialcode.Validate(req.FilialCode); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	rowsAffected, err := i.strg.Filial().Update(ctx, req)
 
 	if err != nil {
-		i.log.Error("!!!UpdateFilial--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!UpdateFilial--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	if rowsAffected <= 0 {
@@ -93,27 +137,39 @@ func (i *FilialService) Update(ctx context.Context, req *organization_service.Up
 
 	resp, err = i.strg.Filial().GetByID(ctx, &organization_service.FilialPK{Id: req.Id})
 	if err != nil {
-		i.log.Error("!!!GetFilial->Filial->Get--->", logger.Error(err))
-		return nil, status.Error(codes.NotFound, err.Error())
+		logger.FromContext(ctx).Error("!!!GetFilial->Filial->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventFilialUpdated, resp.Id, resp)
+
 	return resp, err
 }
 
 func (i *FilialService) UpdatePatch(ctx context.Context, req *organization_service.UpdatePatchFilial) (resp *organization_service.Filial, err error) {
 
-	i.log.Info("---UpdatePatchFilial------>", logger.Any("req", req))
-
 	updatePatchModel := models.UpdatePatchRequest{
 		Id:     req.GetId(),
 		Fields: req.GetFields().AsMap(),
 	}
 
+	if err = normalizePatchPhone(i.cfg, updatePatchModel.Fields); err != nil {
+		return nil, err
+	}
+
+	if err = checkPatchTimeZone(updatePatchModel.Fields); err != nil {
+		return nil, err
+	}
+
+	if err = normalizePatchFilialCode(updatePatchModel.Fields); err != nil {
+		return nil, err
+	}
+
 	rowsAffected, err := i.strg.Filial().UpdatePatch(ctx, &updatePatchModel)
 
 	if err != nil {
-		i.log.Error("!!!UpdatePatchFilial--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!UpdatePatchFilial--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	if rowsAffected <= 0 {
@@ -122,23 +178,114 @@ func (i *FilialService) UpdatePatch(ctx context.Context, req *organization_servi
 
 	resp, err = i.strg.Filial().GetByID(ctx, &organization_service.FilialPK{Id: req.Id})
 	if err != nil {
-		i.log.Error("!!!GetFilial->Filial->Get--->", logger.Error(err))
+		logger.FromContext(ctx).Error("!!!GetFilial->Filial->Get--->", logger.Error(err))
 
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, storageError(err, codes.NotFound)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventFilialUpdated, resp.Id, resp)
+
 	return resp, err
 }
 
 func (i *FilialService) Delete(ctx context.Context, req *organization_service.FilialPK) (resp *empty.Empty, err error) {
 
-	i.log.Info("---DeleteFilial------>", logger.Any("req", req))
-
 	err = i.strg.Filial().Delete(ctx, req)
 	if err != nil {
-		i.log.Error("!!!DeleteFilial->Filial->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!DeleteFilial->Filial->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventFilialDeleted, req.Id, req)
+
 	return &empty.Empty{}, nil
 }
+
+func (i *FilialService) PreviewCode(ctx context.Context, req *organization_service.PreviewFilialCodeRequest) (resp *organization_service.PreviewFilialCodeResponse, err error) {
+
+	seq, err := i.strg.Filial().PeekCodeSequence(ctx, i.codes.Key(req.Region))
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!PreviewFilialCode->Filial->PeekCodeSequence--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	code, err := i.codes.Format(req.Region, seq)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!PreviewFilialCode->Format--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &organization_service.PreviewFilialCodeResponse{FilialCode: code}, nil
+}
+
+func (i *FilialService) FindNearest(ctx context.Context, req *organization_service.FindNearestFilialsRequest) (resp *organization_service.FindNearestFilialsResponse, err error) {
+
+	if req.Radius == 0 {
+		req.Radius = defaultNearestRadius
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultNearestLimit
+	}
+
+	resp, err = i.strg.Filial().FindNearest(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!FindNearestFilials->Filial->FindNearest--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	return
+}
+
+// createWithGeneratedCode creates the filial with the next code of its
+// region. Codes taken by explicitly set ones are skipped.
+func (i *FilialService) createWithGeneratedCode(ctx context.Context, req *organization_service.CreateFilial) (pKey *organization_service.FilialPK, err error) {
+	key := i.codes.Key(req.Region)
+
+	for attempt := 0; attempt < maxFilialCodeAttempts; attempt++ {
+		seq, err := i.strg.Filial().NextCodeSequence(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		req.FilialCode, err = i.codes.Format(req.Region, seq)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		pKey, err = i.strg.Filial().Create(ctx, req)
+		if !errors.Is(err, storage.ErrAlreadyExists) {
+			return pKey, err
+		}
+	}
+
+	return nil, status.Error(codes.Aborted, "could not generate a free filial code")
+}
+
+// normalizePatchFilialCode normalizes and checks the region and the filial
+// code of a patch, if present, the way Update does.
+func normalizePatchFilialCode(fields map[string]interface{}) error {
+	if value, ok := fields["region"]; ok {
+		region, ok := value.(string)
+		if !ok {
+			return status.Error(codes.InvalidArgument, "region must be a string")
+		}
+
+		fields["region"] = filialcode.NormalizeRegion(region)
+	}
+
+	if value, ok := fields["filial_code"]; ok {
+		code, ok := value.(string)
+		if !ok {
+			return status.Error(codes.InvalidArgument, "filial_code must be a string")
+		}
+
+		code = filialcode.Normalize(code)
+		if err := filialcode.Validate(code); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		fields["filial_code"] = code
+	}
+
+	return nil
+}
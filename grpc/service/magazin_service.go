@@ -7,6 +7,7 @@ import (
 	"organization_service/grpc/client"
 	"organization_service/models"
 	"organization_service/pkg/logger"
+	"organization_service/pkg/webhook"
 	"organization_service/storage"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -33,31 +34,29 @@ func NewMagazinService(cfg config.Config, log logger.LoggerI, strg storage.Stora
 
 func (i *MagazinService) Create(ctx context.Context, req *organization_service.CreateMagazin) (resp *organization_service.Magazin, err error) {
 
-	i.log.Info("---CreateMagazin----->", logger.Any("req", req))
-
 	pKey, err := i.strg.Magazin().Create(ctx, req)
 	if err != nil {
-		i.log.Error("!!!CreateMagazin>Magazin>Create--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!CreateMagazin>Magazin>Create--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	resp, err = i.strg.Magazin().GetByID(ctx, pKey)
 	if err != nil {
-		i.log.Error("!!!GetByPKeyMagazin>Magazin>Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetByPKeyMagazin>Magazin>Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventMagazinCreated, resp.FilialId, resp)
+
 	return
 }
 
 func (i *MagazinService) GetByID(ctx context.Context, req *organization_service.MagazinPK) (resp *organization_service.Magazin, err error) {
 
-	i.log.Info("---GetMagazinByID------>", logger.Any("req", req))
-
 	resp, err = i.strg.Magazin().GetByID(ctx, req)
 	if err != nil {
-		i.log.Error("!!!GetMagazinByID->Magazin>Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetMagazinByID->Magazin>Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	return
@@ -65,12 +64,10 @@ func (i *MagazinService) GetByID(ctx context.Context, req *organization_service.
 
 func (i *MagazinService) GetList(ctx context.Context, req *organization_service.GetListMagazinRequest) (resp *organization_service.GetListMagazinResponse, err error) {
 
-	i.log.Info("---GetMagazins------>", logger.Any("req", req))
-
 	resp, err = i.strg.Magazin().GetList(ctx, req)
 	if err != nil {
-		i.log.Error("!!!GetMagazins->Magazin>Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetMagazins->Magazin>Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	return
@@ -78,13 +75,11 @@ func (i *MagazinService) GetList(ctx context.Context, req *organization_service.
 
 func (i *MagazinService) Update(ctx context.Context, req *organization_service.UpdateMagazin) (resp *organization_service.Magazin, err error) {
 
-	i.log.Info("---UpdateMagazin----->", logger.Any("req", req))
-
 	rowsAffected, err := i.strg.Magazin().Update(ctx, req)
 
 	if err != nil {
-		i.log.Error("!!!UpdateMagazin-->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!UpdateMagazin-->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	if rowsAffected <= 0 {
@@ -93,17 +88,17 @@ func (i *MagazinService) Update(ctx context.Context, req *organization_service.U
 
 	resp, err = i.strg.Magazin().GetByID(ctx, &organization_service.MagazinPK{Id: req.Id})
 	if err != nil {
-		i.log.Error("!!!GetMagazin>Magazin>Get--->", logger.Error(err))
-		return nil, status.Error(codes.NotFound, err.Error())
+		logger.FromContext(ctx).Error("!!!GetMagazin>Magazin>Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventMagazinUpdated, resp.FilialId, resp)
+
 	return resp, err
 }
 
 func (i *MagazinService) UpdatePatch(ctx context.Context, req *organization_service.UpdatePatchMagazin) (resp *organization_service.Magazin, err error) {
 
-	i.log.Info("---UpdatePatchMagazin----->", logger.Any("req", req))
-
 	updatePatchModel := models.UpdatePatchRequest{
 		Id:     req.GetId(),
 		Fields: req.GetFields().AsMap(),
@@ -112,8 +107,8 @@ func (i *MagazinService) UpdatePatch(ctx context.Context, req *organization_serv
 	rowsAffected, err := i.strg.Magazin().UpdatePatch(ctx, &updatePatchModel)
 
 	if err != nil {
-		i.log.Error("!!!UpdatePatchMagazin-->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!UpdatePatchMagazin-->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	if rowsAffected <= 0 {
@@ -122,23 +117,27 @@ func (i *MagazinService) UpdatePatch(ctx context.Context, req *organization_serv
 
 	resp, err = i.strg.Magazin().GetByID(ctx, &organization_service.MagazinPK{Id: req.Id})
 	if err != nil {
-		i.log.Error("!!!GetMagazin>Magazin>Get--->", logger.Error(err))
+		logger.FromContext(ctx).Error("!!!GetMagazin>Magazin>Get--->", logger.Error(err))
 
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, storageError(err, codes.NotFound)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventMagazinUpdated, resp.FilialId, resp)
+
 	return resp, err
 }
 
 func (i *MagazinService) Delete(ctx context.Context, req *organization_service.MagazinPK) (resp *empty.Empty, err error) {
 
-	i.log.Info("---DeleteMagazin----->", logger.Any("req", req))
+	filialID := magazinFilialID(ctx, i.strg, req.Id)
 
 	err = i.strg.Magazin().Delete(ctx, req)
 	if err != nil {
-		i.log.Error("!!!DeleteMagazin>Magazin>Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!DeleteMagazin>Magazin>Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventMagazinDeleted, filialID, req)
+
 	return &empty.Empty{}, nil
 }
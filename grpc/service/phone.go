@@ -0,0 +1,41 @@
+package service
+
+import (
+	"organization_service/config"
+	"organization_service/pkg/phone"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// normalizePhone returns raw in E.164 form or an InvalidArgument error.
+func normalizePhone(cfg config.Config, raw string) (string, error) {
+	normalized, err := phone.Normalize(raw, cfg.PhoneDefaultCountryCode)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return normalized, nil
+}
+
+// normalizePatchPhone normalizes the phone of UpdatePatch fields, if set.
+func normalizePatchPhone(cfg config.Config, fields map[string]interface{}) error {
+	value, ok := fields["phone"]
+	if !ok {
+		return nil
+	}
+
+	raw, ok := value.(string)
+	if !ok {
+		return status.Error(codes.InvalidArgument, "phone must be a string")
+	}
+
+	normalized, err := normalizePhone(cfg, raw)
+	if err != nil {
+		return err
+	}
+
+	fields["phone"] = normalized
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"organization_service/genproto/product_service"
+	"organization_service/grpc/client"
+	"organization_service/pkg/logger"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkProduct makes sure the product exists in product_service, the tables
+// referencing products have no foreign key to them.
+func checkProduct(ctx context.Context, services client.ServiceManagerI, productID string) error {
+
+	_, err := services.ProductService().GetByID(ctx, &product_service.ProductPK{Id: productID})
+	if err == nil {
+		return nil
+	}
+
+	if status.Code(err) == codes.NotFound {
+		return status.Errorf(codes.InvalidArgument, "product %s does not exist", productID)
+	}
+
+	logger.FromContext(ctx).Error("!!!CheckProduct->ProductService->GetByID--->", logger.Error(err))
+	return status.Error(codes.Unavailable, "could not check the product")
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"organization_service/genproto/organization_service"
+	"organization_service/models"
+	"organization_service/pkg/dedup"
+	"organization_service/pkg/logger"
+	"organization_service/pkg/webhook"
+
+	"google.golang.org/grpc/codes"
+)
+
+// defaultNameSimilarity is how similar normalized provider names must be for
+// FindDuplicates when the request doesn't say.
+const defaultNameSimilarity = 0.85
+
+// FindDuplicates clusters the providers sharing a phone or an INN, or having
+// similar names, for a person to decide which ones to merge.
+func (i *ProviderService) FindDuplicates(ctx context.Context, req *organization_service.FindProviderDuplicatesRequest) (resp *organization_service.FindProviderDuplicatesResponse, err error) {
+
+	providers, err := i.strg.Provider().GetList(ctx, &organization_service.GetListProviderRequest{}, scorePeriodStart(i.cfg.ProviderScorePeriodDays))
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!FindProviderDuplicates->Provider->GetList--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	nameSimilarity := req.NameSimilarity
+	if nameSimilarity == 0 {
+		nameSimilarity = defaultNameSimilarity
+	}
+
+	records := make([]dedup.Record, 0, len(providers.Providers))
+	byID := make(map[string]*organization_service.Provider, len(providers.Providers))
+	for _, provider := range providers.Providers {
+		records = append(records, dedup.Record{
+			ID:    provider.Id,
+			Phone: provider.Phone,
+			INN:   provider.Inn,
+			Name:  provider.Name,
+		})
+		byID[provider.Id] = provider
+	}
+
+	clusters := dedup.Find(records, nameSimilarity)
+
+	resp = &organization_service.FindProviderDuplicatesResponse{Count: int64(len(clusters))}
+	for _, cluster := range clusters {
+		if req.Limit > 0 && int64(len(resp.Clusters)) >= req.Limit {
+			break
+		}
+
+		found := &organization_service.ProviderDuplicateCluster{Reasons: cluster.Reasons}
+		for _, id := range cluster.IDs {
+			found.Providers = append(found.Providers, byID[id])
+		}
+		resp.Clusters = append(resp.Clusters, found)
+	}
+
+	return resp, nil
+}
+
+// Merge merges the source providers into the target one. Everything that
+// referenced the sources moves to the target and the ids of the sources keep
+// resolving to it with GetByID.
+func (i *ProviderService) Merge(ctx context.Context, req *organization_service.MergeProvidersRequest) (resp *organization_service.Provider, err error) {
+
+	err = i.strg.Provider().Merge(ctx, &models.ProviderMerge{
+		TargetId:  req.TargetId,
+		SourceIds: req.SourceIds,
+		MergedBy:  actorID(ctx, i.tokens),
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!MergeProviders->Provider->Merge--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	resp, err = i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.TargetId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!MergeProviders->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventProviderMerged, "", req)
+
+	return resp, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"organization_service/genproto/organization_service"
+	"organization_service/pkg/logger"
+	"organization_service/pkg/validation"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecordDelivery records the outcome of a delivery of the provider. A
+// delivery linked to a purchase order takes its dates from the order.
+func (i *ProviderService) RecordDelivery(ctx context.Context, req *organization_service.RecordProviderDeliveryRequest) (resp *organization_service.ProviderDelivery, err error) {
+
+	_, err = i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.ProviderId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RecordProviderDelivery->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if req.PurchaseOrderId != "" {
+		if err = i.deliveryFromOrder(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err = i.strg.ProviderPerformance().CreateDelivery(ctx, req, actorID(ctx, i.tokens))
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RecordProviderDelivery->ProviderPerformance->CreateDelivery--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *ProviderService) ListDeliveries(ctx context.Context, req *organization_service.ListProviderDeliveriesRequest) (resp *organization_service.ListProviderDeliveriesResponse, err error) {
+
+	resp, err = i.strg.ProviderPerformance().GetDeliveryList(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ListProviderDeliveries->ProviderPerformance->GetDeliveryList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+// Rate rates the provider on behalf of the calling staff, replacing the
+// previous rating of the staff.
+func (i *ProviderService) Rate(ctx context.Context, req *organization_service.RateProviderRequest) (resp *organization_service.ProviderRating, err error) {
+
+	_, err = i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.ProviderId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RateProvider->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	ratedBy := actorID(ctx, i.tokens)
+	if ratedBy == "" {
+		return nil, status.Error(codes.Unauthenticated, "rating a provider needs the access token of a staff")
+	}
+
+	resp, err = i.strg.ProviderPerformance().UpsertRating(ctx, req, ratedBy)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RateProvider->ProviderPerformance->UpsertRating--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *ProviderService) ListRatings(ctx context.Context, req *organization_service.ListProviderRatingsRequest) (resp *organization_service.ListProviderRatingsResponse, err error) {
+
+	resp, err = i.strg.ProviderPerformance().GetRatingList(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ListProviderRatings->ProviderPerformance->GetRatingList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *ProviderService) GetScorecard(ctx context.Context, req *organization_service.GetProviderScorecardRequest) (resp *organization_service.ProviderScorecard, err error) {
+
+	periodDays := req.PeriodDays
+	if periodDays == 0 {
+		periodDays = i.cfg.ProviderScorePeriodDays
+	}
+	since := scorePeriodStart(periodDays)
+
+	resp, err = i.strg.ProviderPerformance().GetScorecard(ctx, req.Id, since)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetProviderScorecard->ProviderPerformance->GetScorecard--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	resp.PeriodDays = periodDays
+	resp.PeriodFrom = since.Format(validation.DateLayout)
+
+	return resp, nil
+}
+
+// deliveryFromOrder checks that the purchase order of the delivery is a
+// received order of the provider and fills the delivery dates from it.
+func (i *ProviderService) deliveryFromOrder(ctx context.Context, req *organization_service.RecordProviderDeliveryRequest) error {
+	order, err := i.strg.PurchaseOrder().GetByID(ctx, &organization_service.PurchaseOrderPK{Id: req.PurchaseOrderId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RecordProviderDelivery->PurchaseOrder->Get--->", logger.Error(err))
+		return storageError(err, codes.NotFound)
+	}
+
+	if order.ProviderId != req.ProviderId {
+		return status.Error(codes.InvalidArgument, "purchase order belongs to another provider")
+	}
+
+	if order.Status != organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_RECEIVED {
+		return status.Errorf(codes.FailedPrecondition, "purchase order is %s, only received orders have a delivery outcome", order.Status)
+	}
+
+	if req.DeliveredOn == "" && len(order.ReceivedAt) >= len(validation.DateLayout) {
+		req.DeliveredOn = order.ReceivedAt[:len(validation.DateLayout)]
+	}
+
+	if order.ExpectedDeliveryDate == "" {
+		return nil
+	}
+
+	delivered := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.DeliveredOn != "" {
+		delivered, _ = time.Parse(validation.DateLayout, req.DeliveredOn)
+	}
+	expected, err := time.Parse(validation.DateLayout, order.ExpectedDeliveryDate)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	req.DaysLate = 0
+	if days := int32(delivered.Sub(expected).Hours() / 24); days > 0 {
+		req.DaysLate = days
+	}
+
+	return nil
+}
+
+// scorePeriodStart returns the first day of a scoring period of days days
+// ending today.
+func scorePeriodStart(days int32) time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -int(days))
+}
@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"organization_service/config"
 	"organization_service/genproto/organization_service"
 	"organization_service/grpc/client"
 	"organization_service/models"
+	"organization_service/pkg/auth"
 	"organization_service/pkg/logger"
+	"organization_service/pkg/webhook"
 	"organization_service/storage"
+	"sort"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -19,6 +23,7 @@ type ProviderService struct {
 	log      logger.LoggerI
 	strg     storage.StorageI
 	services client.ServiceManagerI
+	tokens   *auth.TokenManager
 	*organization_service.UnimplementedProviderServiceServer
 }
 
@@ -28,49 +33,85 @@ func NewProviderService(cfg config.Config, log logger.LoggerI, strg storage.Stor
 		log:      log,
 		strg:     strg,
 		services: srvs,
+		tokens:   auth.NewTokenManager(cfg.JWTSecret, cfg.AccessTokenTTL),
 	}
 }
 
 func (i *ProviderService) Create(ctx context.Context, req *organization_service.CreateProvider) (resp *organization_service.Provider, err error) {
 
-	i.log.Info("---CreateProvider------>", logger.Any("req", req))
+	if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+		return nil, err
+	}
+
+	if err = normalizeRequisites(&req.Inn, &req.Mfo, &req.AccountNumber); err != nil {
+		return nil, err
+	}
 
 	pKey, err := i.strg.Provider().Create(ctx, req)
 	if err != nil {
-		i.log.Error("!!!CreateProvider->Provider->Create--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!CreateProvider->Provider->Create--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	resp, err = i.strg.Provider().GetByID(ctx, pKey)
 	if err != nil {
-		i.log.Error("!!!GetByPKeyProvider->Provider->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetByPKeyProvider->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventProviderCreated, "", resp)
+
 	return
 }
 
 func (i *ProviderService) GetByID(ctx context.Context, req *organization_service.ProviderPK) (resp *organization_service.Provider, err error) {
 
-	i.log.Info("---GetProviderByID------>", logger.Any("req", req))
-
 	resp, err = i.strg.Provider().GetByID(ctx, req)
+	if errors.Is(err, storage.ErrNotFound) {
+		// the provider may have been merged into another one
+		var id string
+		if id, err = i.strg.Provider().GetRedirect(ctx, req.Id); err == nil {
+			resp, err = i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: id})
+		}
+	}
 	if err != nil {
-		i.log.Error("!!!GetProviderByID->Provider->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetProviderByID->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if req.WithContacts {
+		contacts, err := i.strg.ProviderContact().GetList(ctx, &organization_service.ListProviderContactsRequest{ProviderId: resp.Id})
+		if err != nil {
+			logger.FromContext(ctx).Error("!!!GetProviderByID->ProviderContact->GetList--->", logger.Error(err))
+			return nil, storageError(err, codes.Internal)
+		}
+		resp.Contacts = contacts.Contacts
 	}
 
 	return
 }
 
-func (i *ProviderService) GetList(ctx context.Context, req *organization_service.GetListProviderRequest) (resp *organization_service.GetListProviderResponse, err error) {
+func (i *ProviderService) GetByPhone(ctx context.Context, req *organization_service.ProviderPhoneRequest) (resp *organization_service.Provider, err error) {
 
-	i.log.Info("---GetProviders------>", logger.Any("req", req))
+	if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+		return nil, err
+	}
 
-	resp, err = i.strg.Provider().GetList(ctx, req)
+	resp, err = i.strg.Provider().GetByPhone(ctx, req)
 	if err != nil {
-		i.log.Error("!!!GetProviders->Provider->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetProviderByPhone->Provider->GetByPhone--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *ProviderService) GetList(ctx context.Context, req *organization_service.GetListProviderRequest) (resp *organization_service.GetListProviderResponse, err error) {
+
+	resp, err = i.strg.Provider().GetList(ctx, req, scorePeriodStart(i.cfg.ProviderScorePeriodDays))
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetProviders->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	return
@@ -78,13 +119,19 @@ func (i *ProviderService) GetList(ctx context.Context, req *organization_service
 
 func (i *ProviderService) Update(ctx context.Context, req *organization_service.UpdateProvider) (resp *organization_service.Provider, err error) {
 
-	i.log.Info("---UpdateProvider------>", logger.Any("req", req))
+	if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+		return nil, err
+	}
+
+	if err = normalizeRequisites(&req.Inn, &req.Mfo, &req.AccountNumber); err != nil {
+		return nil, err
+	}
 
 	rowsAffected, err := i.strg.Provider().Update(ctx, req)
 
 	if err != nil {
-		i.log.Error("!!!UpdateProvider--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!UpdateProvider--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	if rowsAffected <= 0 {
@@ -93,27 +140,45 @@ func (i *ProviderService) Update(ctx context.Context, req *organization_service.
 
 	resp, err = i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.Id})
 	if err != nil {
-		i.log.Error("!!!GetProvider->Provider->Get--->", logger.Error(err))
-		return nil, status.Error(codes.NotFound, err.Error())
+		logger.FromContext(ctx).Error("!!!GetProvider->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventProviderUpdated, "", resp)
+
 	return resp, err
 }
 
 func (i *ProviderService) UpdatePatch(ctx context.Context, req *organization_service.UpdatePatchProvider) (resp *organization_service.Provider, err error) {
 
-	i.log.Info("---UpdatePatchProvider------>", logger.Any("req", req))
-
 	updatePatchModel := models.UpdatePatchRequest{
 		Id:     req.GetId(),
 		Fields: req.GetFields().AsMap(),
 	}
 
+	if err = normalizePatchPhone(i.cfg, updatePatchModel.Fields); err != nil {
+		return nil, err
+	}
+
+	var storedMFO, storedAccount string
+	if patchesRequisites(updatePatchModel.Fields) {
+		stored, err := i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.Id})
+		if err != nil {
+			logger.FromContext(ctx).Error("!!!UpdatePatchProvider->Provider->Get--->", logger.Error(err))
+			return nil, storageError(err, codes.NotFound)
+		}
+		storedMFO, storedAccount = stored.Mfo, stored.AccountNumber
+	}
+
+	if err = normalizePatchRequisites(updatePatchModel.Fields, storedMFO, storedAccount); err != nil {
+		return nil, err
+	}
+
 	rowsAffected, err := i.strg.Provider().UpdatePatch(ctx, &updatePatchModel)
 
 	if err != nil {
-		i.log.Error("!!!UpdatePatchProvider--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!UpdatePatchProvider--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	if rowsAffected <= 0 {
@@ -122,23 +187,333 @@ func (i *ProviderService) UpdatePatch(ctx context.Context, req *organization_ser
 
 	resp, err = i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.Id})
 	if err != nil {
-		i.log.Error("!!!GetProvider->Provider->Get--->", logger.Error(err))
+		logger.FromContext(ctx).Error("!!!GetProvider->Provider->Get--->", logger.Error(err))
 
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, storageError(err, codes.NotFound)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventProviderUpdated, "", resp)
+
 	return resp, err
 }
 
 func (i *ProviderService) Delete(ctx context.Context, req *organization_service.ProviderPK) (resp *empty.Empty, err error) {
 
-	i.log.Info("---DeleteProvider------>", logger.Any("req", req))
-
 	err = i.strg.Provider().Delete(ctx, req)
 	if err != nil {
-		i.log.Error("!!!DeleteProvider->Provider->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!DeleteProvider->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventProviderDeleted, "", req)
+
+	return &empty.Empty{}, nil
+}
+
+// ChangeStatus moves the provider to req.Status if the transition is allowed
+// and records the change with the staff who made it.
+func (i *ProviderService) ChangeStatus(ctx context.Context, req *organization_service.ChangeProviderStatusRequest) (resp *organization_service.Provider, err error) {
+
+	provider, err := i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.Id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangeProviderStatus->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if !models.CanChangeProviderStatus(provider.Status, req.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "provider can't be moved from %s to %s", provider.Status, req.Status)
+	}
+
+	rowsAffected, err := i.strg.Provider().ChangeStatus(ctx, &models.ProviderStatusChange{
+		ProviderId: req.Id,
+		From:       provider.Status,
+		To:         req.Status,
+		Reason:     req.Reason,
+		ChangedBy:  actorID(ctx, i.tokens),
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangeProviderStatus->Provider->ChangeStatus--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.Aborted, "provider status was changed concurrently")
+	}
+
+	resp, err = i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.Id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangeProviderStatus->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventProviderUpdated, "", resp)
+
+	return resp, nil
+}
+
+func (i *ProviderService) GetStatusHistory(ctx context.Context, req *organization_service.GetProviderStatusHistoryRequest) (resp *organization_service.GetProviderStatusHistoryResponse, err error) {
+
+	resp, err = i.strg.Provider().GetStatusHistory(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetProviderStatusHistory->Provider->GetStatusHistory--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *ProviderService) AddContact(ctx context.Context, req *organization_service.AddProviderContactRequest) (resp *organization_service.ProviderContact, err error) {
+
+	if req.Phone != "" {
+		if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+			return nil, err
+		}
+	}
+
+	pKey, err := i.strg.ProviderContact().Create(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!AddProviderContact->ProviderContact->Create--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	resp, err = i.strg.ProviderContact().GetByID(ctx, pKey)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!AddProviderContact->ProviderContact->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	i.publishContactsChanged(ctx, req.ProviderId)
+
+	return
+}
+
+func (i *ProviderService) UpdateContact(ctx context.Context, req *organization_service.UpdateProviderContactRequest) (resp *organization_service.ProviderContact, err error) {
+
+	if req.Phone != "" {
+		if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+			return nil, err
+		}
+	}
+
+	rowsAffected, err := i.strg.ProviderContact().Update(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UpdateProviderContact->ProviderContact->Update--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "contact not found")
+	}
+
+	resp, err = i.strg.ProviderContact().GetByID(ctx, &organization_service.ProviderContactPK{Id: req.Id, ProviderId: req.ProviderId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UpdateProviderContact->ProviderContact->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	i.publishContactsChanged(ctx, req.ProviderId)
+
+	return resp, nil
+}
+
+func (i *ProviderService) RemoveContact(ctx context.Context, req *organization_service.ProviderContactPK) (resp *empty.Empty, err error) {
+
+	_, err = i.strg.ProviderContact().GetByID(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RemoveProviderContact->ProviderContact->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	err = i.strg.ProviderContact().Delete(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RemoveProviderContact->ProviderContact->Delete--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	i.publishContactsChanged(ctx, req.ProviderId)
+
+	return &empty.Empty{}, nil
+}
+
+func (i *ProviderService) ListContacts(ctx context.Context, req *organization_service.ListProviderContactsRequest) (resp *organization_service.ListProviderContactsResponse, err error) {
+
+	resp, err = i.strg.ProviderContact().GetList(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ListProviderContacts->ProviderContact->GetList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+// publishContactsChanged publishes the provider with its contacts after one
+// of them changed.
+func (i *ProviderService) publishContactsChanged(ctx context.Context, providerID string) {
+	provider, err := i.GetByID(ctx, &organization_service.ProviderPK{Id: providerID, WithContacts: true})
+	if err != nil {
+		return
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventProviderUpdated, "", provider)
+}
+
+// Assign makes the provider deliver to the magazin, or changes the terms if
+// it already does. Blacklisted and archived providers can't be assigned.
+func (i *ProviderService) Assign(ctx context.Context, req *organization_service.AssignProviderRequest) (resp *organization_service.ProviderAssignment, err error) {
+
+	provider, err := i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.ProviderId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!AssignProvider->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if !models.ProviderCanBeAssigned(provider.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "provider is %s and can't be assigned", provider.Status)
+	}
+
+	sort.Slice(req.DeliveryDays, func(a, b int) bool { return req.DeliveryDays[a] < req.DeliveryDays[b] })
+
+	err = i.strg.ProviderMagazin().Upsert(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!AssignProvider->ProviderMagazin->Upsert--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	resp, err = i.strg.ProviderMagazin().Get(ctx, req.ProviderId, req.MagazinId)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!AssignProvider->ProviderMagazin->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	return resp, nil
+}
+
+func (i *ProviderService) Unassign(ctx context.Context, req *organization_service.UnassignProviderRequest) (resp *empty.Empty, err error) {
+
+	rowsAffected, err := i.strg.ProviderMagazin().Delete(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UnassignProvider->ProviderMagazin->Delete--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "provider is not assigned to the magazin")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *ProviderService) ListMagazinProviders(ctx context.Context, req *organization_service.ListMagazinProvidersRequest) (resp *organization_service.ListProviderAssignmentsResponse, err error) {
+
+	resp, err = i.strg.ProviderMagazin().GetList(ctx, &models.ProviderAssignmentFilter{
+		MagazinId: req.MagazinId,
+		ActiveOn:  req.ActiveOn,
+		Offset:    req.Offset,
+		Limit:     req.Limit,
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ListMagazinProviders->ProviderMagazin->GetList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *ProviderService) ListProviderMagazins(ctx context.Context, req *organization_service.ListProviderMagazinsRequest) (resp *organization_service.ListProviderAssignmentsResponse, err error) {
+
+	resp, err = i.strg.ProviderMagazin().GetList(ctx, &models.ProviderAssignmentFilter{
+		ProviderId: req.ProviderId,
+		FilialId:   req.FilialId,
+		ActiveOn:   req.ActiveOn,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ListProviderMagazins->ProviderMagazin->GetList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *ProviderService) AddProduct(ctx context.Context, req *organization_service.AddProviderProductRequest) (resp *organization_service.ProviderProduct, err error) {
+
+	provider, err := i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.ProviderId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!AddProviderProduct->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if !models.ProviderCanBeAssigned(provider.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "provider is %s and can't sell products", provider.Status)
+	}
+
+	if err = checkProduct(ctx, i.services, req.ProductId); err != nil {
+		return nil, err
+	}
+
+	if req.MinOrderQty == 0 {
+		req.MinOrderQty = 1
+	}
+
+	pKey, err := i.strg.ProviderProduct().Create(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!AddProviderProduct->ProviderProduct->Create--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	resp, err = i.strg.ProviderProduct().GetByID(ctx, pKey)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!AddProviderProduct->ProviderProduct->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *ProviderService) UpdateProduct(ctx context.Context, req *organization_service.UpdateProviderProductRequest) (resp *organization_service.ProviderProduct, err error) {
+
+	rowsAffected, err := i.strg.ProviderProduct().Update(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UpdateProviderProduct->ProviderProduct->Update--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "provider product not found")
+	}
+
+	resp, err = i.strg.ProviderProduct().GetByID(ctx, &organization_service.ProviderProductPK{Id: req.Id, ProviderId: req.ProviderId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UpdateProviderProduct->ProviderProduct->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	return resp, nil
+}
+
+func (i *ProviderService) RemoveProduct(ctx context.Context, req *organization_service.ProviderProductPK) (resp *empty.Empty, err error) {
+
+	rowsAffected, err := i.strg.ProviderProduct().Delete(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RemoveProviderProduct->ProviderProduct->Delete--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "provider product not found")
 	}
 
 	return &empty.Empty{}, nil
 }
+
+func (i *ProviderService) ListProducts(ctx context.Context, req *organization_service.ListProviderProductsRequest) (resp *organization_service.ListProviderProductsResponse, err error) {
+
+	resp, err = i.strg.ProviderProduct().GetList(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ListProviderProducts->ProviderProduct->GetList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"organization_service/config"
+	"organization_service/genproto/organization_service"
+	"organization_service/grpc/client"
+	"organization_service/models"
+	"organization_service/pkg/auth"
+	"organization_service/pkg/helper"
+	"organization_service/pkg/logger"
+	"organization_service/pkg/webhook"
+	"organization_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PurchaseOrderService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	tokens   *auth.TokenManager
+	*organization_service.UnimplementedPurchaseOrderServiceServer
+}
+
+// maxOrderNumberAttempts bounds how many random order numbers Create tries
+// when they are already taken.
+const maxOrderNumberAttempts = 5
+
+func NewPurchaseOrderService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *PurchaseOrderService {
+	return &PurchaseOrderService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+		tokens:   auth.NewTokenManager(cfg.JWTSecret, cfg.AccessTokenTTL),
+	}
+}
+
+func (i *PurchaseOrderService) Create(ctx context.Context, req *organization_service.CreatePurchaseOrder) (resp *organization_service.PurchaseOrder, err error) {
+
+	magazin, err := i.strg.Magazin().GetByID(ctx, &organization_service.MagazinPK{Id: req.MagazinId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!CreatePurchaseOrder->Magazin->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	provider, err := i.strg.Provider().GetByID(ctx, &organization_service.ProviderPK{Id: req.ProviderId})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!CreatePurchaseOrder->Provider->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if !models.ProviderCanBeAssigned(provider.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "provider is %s and can't be ordered from", provider.Status)
+	}
+
+	if err = i.resolveItems(ctx, req.ProviderId, req.Items); err != nil {
+		return nil, err
+	}
+
+	order := &models.PurchaseOrderCreate{
+		MagazinId:            req.MagazinId,
+		ProviderId:           req.ProviderId,
+		ExpectedDeliveryDate: req.ExpectedDeliveryDate,
+		Comment:              req.Comment,
+		CreatedBy:            actorID(ctx, i.tokens),
+		Items:                req.Items,
+	}
+
+	pKey, err := i.createWithOrderNumber(ctx, order)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!CreatePurchaseOrder->PurchaseOrder->Create--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	resp, err = i.strg.PurchaseOrder().GetByID(ctx, pKey)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!CreatePurchaseOrder->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventPurchaseOrderCreated, magazin.FilialId, resp)
+
+	return
+}
+
+func (i *PurchaseOrderService) GetByID(ctx context.Context, req *organization_service.PurchaseOrderPK) (resp *organization_service.PurchaseOrder, err error) {
+
+	resp, err = i.strg.PurchaseOrder().GetByID(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetPurchaseOrderByID->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *PurchaseOrderService) GetList(ctx context.Context, req *organization_service.GetListPurchaseOrderRequest) (resp *organization_service.GetListPurchaseOrderResponse, err error) {
+
+	resp, err = i.strg.PurchaseOrder().GetList(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetPurchaseOrders->PurchaseOrder->GetList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+// Update replaces the terms and the items of a draft order, orders that were
+// submitted can only change their status.
+func (i *PurchaseOrderService) Update(ctx context.Context, req *organization_service.UpdatePurchaseOrder) (resp *organization_service.PurchaseOrder, err error) {
+
+	order, err := i.getDraft(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = i.resolveItems(ctx, order.ProviderId, req.Items); err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := i.strg.PurchaseOrder().UpdateDraft(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UpdatePurchaseOrder->PurchaseOrder->UpdateDraft--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.Aborted, "purchase order status was changed concurrently")
+	}
+
+	resp, err = i.strg.PurchaseOrder().GetByID(ctx, &organization_service.PurchaseOrderPK{Id: req.Id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UpdatePurchaseOrder->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventPurchaseOrderUpdated, magazinFilialID(ctx, i.strg, resp.MagazinId), resp)
+
+	return resp, nil
+}
+
+// Delete deletes a draft order, orders that were submitted are cancelled
+// instead.
+func (i *PurchaseOrderService) Delete(ctx context.Context, req *organization_service.PurchaseOrderPK) (resp *empty.Empty, err error) {
+
+	order, err := i.getDraft(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := i.strg.PurchaseOrder().DeleteDraft(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!DeletePurchaseOrder->PurchaseOrder->DeleteDraft--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.Aborted, "purchase order status was changed concurrently")
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventPurchaseOrderDeleted, magazinFilialID(ctx, i.strg, order.MagazinId), req)
+
+	return &empty.Empty{}, nil
+}
+
+func (i *PurchaseOrderService) ChangeStatus(ctx context.Context, req *organization_service.ChangePurchaseOrderStatusRequest) (resp *organization_service.PurchaseOrder, err error) {
+
+	order, err := i.strg.PurchaseOrder().GetByID(ctx, &organization_service.PurchaseOrderPK{Id: req.Id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangePurchaseOrderStatus->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if !models.CanChangePurchaseOrderStatus(order.Status, req.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "purchase order can't be moved from %s to %s", order.Status, req.Status)
+	}
+
+	rowsAffected, err := i.strg.PurchaseOrder().ChangeStatus(ctx, &models.PurchaseOrderStatusChange{
+		Id:   req.Id,
+		From: order.Status,
+		To:   req.Status,
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangePurchaseOrderStatus->PurchaseOrder->ChangeStatus--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.Aborted, "purchase order status was changed concurrently")
+	}
+
+	resp, err = i.strg.PurchaseOrder().GetByID(ctx, &organization_service.PurchaseOrderPK{Id: req.Id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangePurchaseOrderStatus->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	publishEvent(ctx, i.strg, webhook.EventPurchaseOrderUpdated, magazinFilialID(ctx, i.strg, resp.MagazinId), resp)
+
+	return resp, nil
+}
+
+// getDraft returns the order if it is still a draft.
+func (i *PurchaseOrderService) getDraft(ctx context.Context, id string) (*organization_service.PurchaseOrder, error) {
+	order, err := i.strg.PurchaseOrder().GetByID(ctx, &organization_service.PurchaseOrderPK{Id: id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetDraftPurchaseOrder->PurchaseOrder->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if order.Status != organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_DRAFT {
+		return nil, status.Errorf(codes.FailedPrecondition, "purchase order is %s, only drafts can be changed", order.Status)
+	}
+
+	return order, nil
+}
+
+// resolveItems checks the products of the items against product_service and
+// the catalog of the provider. Items without a unit price take the purchase
+// price of the catalog, products outside the catalog need one.
+func (i *PurchaseOrderService) resolveItems(ctx context.Context, providerID string, items []*organization_service.PurchaseOrderItemInput) error {
+	for _, item := range items {
+		if err := checkProduct(ctx, i.services, item.ProductId); err != nil {
+			return err
+		}
+
+		catalog, err := i.strg.ProviderProduct().GetList(ctx, &organization_service.ListProviderProductsRequest{
+			ProviderId: providerID,
+			ProductId:  item.ProductId,
+		})
+		if err != nil {
+			logger.FromContext(ctx).Error("!!!ResolvePurchaseOrderItems->ProviderProduct->GetList--->", logger.Error(err))
+			return storageError(err, codes.Internal)
+		}
+
+		if len(catalog.Products) == 0 {
+			if item.UnitPrice == 0 {
+				return status.Errorf(codes.InvalidArgument, "product %s is not in the catalog of the provider, unit_price is required", item.ProductId)
+			}
+			continue
+		}
+
+		product := catalog.Products[0]
+		if item.Quantity < product.MinOrderQty {
+			return status.Errorf(codes.InvalidArgument, "product %s is ordered in quantities of at least %d", item.ProductId, product.MinOrderQty)
+		}
+		if item.UnitPrice == 0 {
+			item.UnitPrice = product.PurchasePrice
+		}
+	}
+
+	return nil
+}
+
+// createWithOrderNumber creates the order with a random order number, taken
+// numbers are retried.
+func (i *PurchaseOrderService) createWithOrderNumber(ctx context.Context, req *models.PurchaseOrderCreate) (pKey *organization_service.PurchaseOrderPK, err error) {
+	for attempt := 0; attempt < maxOrderNumberAttempts; attempt++ {
+		number, err := helper.GenerateOrderNomer()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		req.OrderNumber = "PO-" + number
+
+		pKey, err = i.strg.PurchaseOrder().Create(ctx, req)
+		if !errors.Is(err, storage.ErrAlreadyExists) {
+			return pKey, err
+		}
+	}
+
+	return nil, status.Error(codes.Aborted, "could not generate a free purchase order number")
+}
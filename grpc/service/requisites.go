@@ -0,0 +1,83 @@
+package service
+
+import (
+	"organization_service/pkg/requisites"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// normalizeRequisites normalizes the INN, MFO and account number of a
+// provider in place and checks them. Empty ones are left empty, but an
+// account number needs the MFO of its bank.
+func normalizeRequisites(inn, mfo, account *string) error {
+	*inn = requisites.Normalize(*inn)
+	*mfo = requisites.Normalize(*mfo)
+	*account = requisites.Normalize(*account)
+
+	if *inn != "" {
+		if err := requisites.ValidateINN(*inn); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if *mfo != "" {
+		if err := requisites.ValidateMFO(*mfo); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if *account != "" {
+		if *mfo == "" {
+			return status.Error(codes.InvalidArgument, "mfo is required with account_number")
+		}
+		if err := requisites.ValidateAccount(*mfo, *account); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// requisiteFields are the UpdatePatch fields normalizePatchRequisites checks.
+var requisiteFields = []string{"inn", "mfo", "account_number"}
+
+// normalizePatchRequisites normalizes and checks the requisites of
+// UpdatePatch fields, if set. The MFO and account number are checked
+// together, the stored ones fill in for those not being patched. Empty
+// values clear the field.
+func normalizePatchRequisites(fields map[string]interface{}, storedMFO, storedAccount string) error {
+	values := map[string]string{"mfo": storedMFO, "account_number": storedAccount}
+
+	for _, name := range requisiteFields {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+
+		raw, ok := value.(string)
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "%s must be a string", name)
+		}
+
+		values[name] = requisites.Normalize(raw)
+		if values[name] == "" {
+			fields[name] = nil
+		} else {
+			fields[name] = values[name]
+		}
+	}
+
+	inn, mfo, account := values["inn"], values["mfo"], values["account_number"]
+
+	return normalizeRequisites(&inn, &mfo, &account)
+}
+
+// patchesRequisites reports whether UpdatePatch fields change the MFO or the
+// account number, which are checked against the stored ones.
+func patchesRequisites(fields map[string]interface{}) bool {
+	_, mfo := fields["mfo"]
+	_, account := fields["account_number"]
+
+	return mfo || account
+}
@@ -2,16 +2,25 @@ package service
 
 import (
 	"context"
+	"errors"
 	"organization_service/config"
 	"organization_service/genproto/organization_service"
 	"organization_service/grpc/client"
+	"organization_service/grpc/interceptor"
 	"organization_service/models"
+	"organization_service/pkg/auth"
+	"organization_service/pkg/helper"
 	"organization_service/pkg/logger"
+	"organization_service/pkg/sms"
+	"organization_service/pkg/webhook"
 	"organization_service/storage"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type StaffService struct {
@@ -19,58 +28,88 @@ type StaffService struct {
 	log      logger.LoggerI
 	strg     storage.StorageI
 	services client.ServiceManagerI
+	tokens   *auth.TokenManager
+	sms      sms.Sender
 	*organization_service.UnimplementedStaffServiceServer
 }
 
 func NewStaffService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *StaffService {
+	sender, err := sms.NewSender(cfg, log)
+	if err != nil {
+		log.Error("!!!NewStaffService->NewSender--->", logger.Error(err))
+		sender = sms.NewConsoleSender(log)
+	}
+
 	return &StaffService{
 		cfg:      cfg,
 		log:      log,
 		strg:     strg,
 		services: srvs,
+		tokens:   auth.NewTokenManager(cfg.JWTSecret, cfg.AccessTokenTTL),
+		sms:      sender,
 	}
 }
 
 func (i *StaffService) Create(ctx context.Context, req *organization_service.CreateStaff) (resp *organization_service.Staff, err error) {
 
-	i.log.Info("---CreateStaff------>", logger.Any("req", req))
+	if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+		return nil, err
+	}
+
+	if req.Password, err = auth.HashPassword(req.Password); err != nil {
+		logger.FromContext(ctx).Error("!!!CreateStaff->HashPassword--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	pKey, err := i.strg.Staff().Create(ctx, req)
 	if err != nil {
-		i.log.Error("!!!CreateStaff->Staff->Create--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!CreateStaff->Staff->Create--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	resp, err = i.strg.Staff().GetByID(ctx, pKey)
 	if err != nil {
-		i.log.Error("!!!GetByPKeyStaff->Staff->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetByPKeyStaff->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
+	i.publishStaffEvent(ctx, webhook.EventStaffCreated, resp)
+
 	return
 }
 
 func (i *StaffService) GetByID(ctx context.Context, req *organization_service.StaffPK) (resp *organization_service.Staff, err error) {
 
-	i.log.Info("---GetStaffByID------>", logger.Any("req", req))
-
 	resp, err = i.strg.Staff().GetByID(ctx, req)
 	if err != nil {
-		i.log.Error("!!!GetStaffByID->Staff->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetStaffByID->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	return
 }
 
-func (i *StaffService) GetList(ctx context.Context, req *organization_service.GetListStaffRequest) (resp *organization_service.GetListStaffResponse, err error) {
+func (i *StaffService) GetByPhone(ctx context.Context, req *organization_service.StaffPhoneRequest) (resp *organization_service.Staff, err error) {
 
-	i.log.Info("---GetStaffs------>", logger.Any("req", req))
+	if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+		return nil, err
+	}
+
+	resp, err = i.strg.Staff().GetByPhone(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetStaffByPhone->Staff->GetByPhone--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *StaffService) GetList(ctx context.Context, req *organization_service.GetListStaffRequest) (resp *organization_service.GetListStaffResponse, err error) {
 
 	resp, err = i.strg.Staff().GetList(ctx, req)
 	if err != nil {
-		i.log.Error("!!!GetStaffs->Staff->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!GetStaffs->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	return
@@ -78,13 +117,20 @@ func (i *StaffService) GetList(ctx context.Context, req *organization_service.Ge
 
 func (i *StaffService) Update(ctx context.Context, req *organization_service.UpdateStaff) (resp *organization_service.Staff, err error) {
 
-	i.log.Info("---UpdateStaff------>", logger.Any("req", req))
+	if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+		return nil, err
+	}
+
+	if req.Password, err = auth.HashPassword(req.Password); err != nil {
+		logger.FromContext(ctx).Error("!!!UpdateStaff->HashPassword--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	rowsAffected, err := i.strg.Staff().Update(ctx, req)
 
 	if err != nil {
-		i.log.Error("!!!UpdateStaff--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!UpdateStaff--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	if rowsAffected <= 0 {
@@ -93,27 +139,38 @@ func (i *StaffService) Update(ctx context.Context, req *organization_service.Upd
 
 	resp, err = i.strg.Staff().GetByID(ctx, &organization_service.StaffPK{Id: req.Id})
 	if err != nil {
-		i.log.Error("!!!GetStaff->Staff->Get--->", logger.Error(err))
-		return nil, status.Error(codes.NotFound, err.Error())
+		logger.FromContext(ctx).Error("!!!GetStaff->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
 	}
 
+	i.publishStaffEvent(ctx, webhook.EventStaffUpdated, resp)
+
 	return resp, err
 }
 
 func (i *StaffService) UpdatePatch(ctx context.Context, req *organization_service.UpdatePatchStaff) (resp *organization_service.Staff, err error) {
 
-	i.log.Info("---UpdatePatchStaff------>", logger.Any("req", req))
-
 	updatePatchModel := models.UpdatePatchRequest{
 		Id:     req.GetId(),
 		Fields: req.GetFields().AsMap(),
 	}
 
+	if err = normalizePatchPhone(i.cfg, updatePatchModel.Fields); err != nil {
+		return nil, err
+	}
+
+	if password, ok := updatePatchModel.Fields["password"].(string); ok {
+		if updatePatchModel.Fields["password"], err = auth.HashPassword(password); err != nil {
+			logger.FromContext(ctx).Error("!!!UpdatePatchStaff->HashPassword--->", logger.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	rowsAffected, err := i.strg.Staff().UpdatePatch(ctx, &updatePatchModel)
 
 	if err != nil {
-		i.log.Error("!!!UpdatePatchStaff--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!UpdatePatchStaff--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
 	if rowsAffected <= 0 {
@@ -122,23 +179,475 @@ func (i *StaffService) UpdatePatch(ctx context.Context, req *organization_servic
 
 	resp, err = i.strg.Staff().GetByID(ctx, &organization_service.StaffPK{Id: req.Id})
 	if err != nil {
-		i.log.Error("!!!GetStaff->Staff->Get--->", logger.Error(err))
+		logger.FromContext(ctx).Error("!!!GetStaff->Staff->Get--->", logger.Error(err))
 
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, storageError(err, codes.NotFound)
 	}
 
+	i.publishStaffEvent(ctx, webhook.EventStaffUpdated, resp)
+
 	return resp, err
 }
 
 func (i *StaffService) Delete(ctx context.Context, req *organization_service.StaffPK) (resp *empty.Empty, err error) {
 
-	i.log.Info("---DeleteStaff------>", logger.Any("req", req))
+	staff, err := i.strg.Staff().GetByID(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!DeleteStaff->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
 
 	err = i.strg.Staff().Delete(ctx, req)
 	if err != nil {
-		i.log.Error("!!!DeleteStaff->Staff->Get--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		logger.FromContext(ctx).Error("!!!DeleteStaff->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
 	}
 
+	publishEvent(ctx, i.strg, webhook.EventStaffDeleted, magazinFilialID(ctx, i.strg, staff.MagazinId), req)
+
 	return &empty.Empty{}, nil
 }
+
+func (i *StaffService) Suspend(ctx context.Context, req *organization_service.StaffStatusRequest) (resp *organization_service.Staff, err error) {
+	return i.changeStatus(ctx, req, models.StaffSuspended, "")
+}
+
+func (i *StaffService) Reactivate(ctx context.Context, req *organization_service.StaffStatusRequest) (resp *organization_service.Staff, err error) {
+	return i.changeStatus(ctx, req, models.StaffActive, models.StaffSuspended)
+}
+
+func (i *StaffService) Terminate(ctx context.Context, req *organization_service.StaffStatusRequest) (resp *organization_service.Staff, err error) {
+	return i.changeStatus(ctx, req, models.StaffTerminated, "")
+}
+
+// changeStatus moves the staff to status to. If from is set the staff has to
+// be in that status, otherwise any allowed transition is made.
+func (i *StaffService) changeStatus(ctx context.Context, req *organization_service.StaffStatusRequest, to, from string) (resp *organization_service.Staff, err error) {
+
+	effectiveAt := time.Now().UTC()
+	if req.EffectiveAt != "" {
+		if effectiveAt, err = time.Parse(time.RFC3339, req.EffectiveAt); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "effective_at must be an RFC 3339 timestamp")
+		}
+		effectiveAt = effectiveAt.UTC()
+	}
+
+	staff, err := i.strg.Staff().GetAuthByID(ctx, req.Id)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangeStaffStatus->Staff->GetAuthByID--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if !models.CanChangeStaffStatus(staff.Status, to) || (from != "" && staff.Status != from) {
+		return nil, status.Errorf(codes.FailedPrecondition, "staff can't be %s, it is %s", to, staff.Status)
+	}
+
+	rowsAffected, err := i.strg.Staff().ChangeStatus(ctx, &models.StaffStatusChange{
+		StaffId:     req.Id,
+		From:        staff.Status,
+		To:          to,
+		Reason:      req.Reason,
+		EffectiveAt: effectiveAt,
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangeStaffStatus->Staff->ChangeStatus--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.Aborted, "staff status was changed concurrently")
+	}
+
+	resp, err = i.strg.Staff().GetByID(ctx, &organization_service.StaffPK{Id: req.Id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ChangeStaffStatus->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	i.publishStaffEvent(ctx, webhook.EventStaffUpdated, resp)
+
+	return resp, nil
+}
+
+func (i *StaffService) Unlock(ctx context.Context, req *organization_service.StaffPK) (resp *organization_service.Staff, err error) {
+
+	rowsAffected, err := i.strg.Staff().Unlock(ctx, req.Id)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UnlockStaff->Staff->Unlock--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "staff not found")
+	}
+
+	resp, err = i.strg.Staff().GetByID(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UnlockStaff->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	i.publishStaffEvent(ctx, webhook.EventStaffUpdated, resp)
+
+	return resp, nil
+}
+
+func (i *StaffService) Login(ctx context.Context, req *organization_service.StaffLoginRequest) (resp *organization_service.StaffLoginResponse, err error) {
+
+	now := time.Now().UTC()
+	clientIP := interceptor.ClientIP(ctx)
+
+	if clientIP != "" {
+		failures, err := i.strg.LoginAttempt().CountFailuresByIP(ctx, clientIP, now.Add(-i.cfg.LoginIPWindow))
+		if err != nil {
+			logger.FromContext(ctx).Error("!!!LoginStaff->LoginAttempt->CountFailuresByIP--->", logger.Error(err))
+			return nil, storageError(err, codes.Internal)
+		}
+
+		if failures >= i.cfg.LoginIPMaxFailures {
+			return nil, status.Error(codes.ResourceExhausted, "too many failed logins, try again later")
+		}
+	}
+
+	attempt := &models.LoginAttempt{
+		Id:        uuid.New().String(),
+		Login:     req.Login,
+		ClientIP:  clientIP,
+		CreatedAt: now,
+	}
+	defer i.recordLoginAttempt(ctx, attempt)
+
+	staff, err := i.strg.Staff().GetAuthByLogin(ctx, req.Login)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.Unauthenticated, "invalid login or password")
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!LoginStaff->Staff->GetAuthByLogin--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	attempt.StaffId = staff.Id
+
+	if staff.Locked(now) {
+		return nil, status.Errorf(codes.PermissionDenied, "staff is locked until %s", staff.LockedUntil.UTC().Format(time.RFC3339))
+	}
+
+	if !auth.CheckPassword(staff.Password, req.Password) {
+		i.registerLoginFailure(ctx, staff.Id, clientIP)
+		return nil, status.Error(codes.Unauthenticated, "invalid login or password")
+	}
+
+	if err = checkStaffStatus(staff); err != nil {
+		return nil, err
+	}
+
+	attempt.Success = true
+
+	if staff.FailedLogins > 0 || staff.Lockouts > 0 {
+		if _, err = i.strg.Staff().Unlock(ctx, staff.Id); err != nil {
+			logger.FromContext(ctx).Error("!!!LoginStaff->Staff->Unlock--->", logger.Error(err))
+		}
+	}
+
+	if !auth.IsHashed(staff.Password) {
+		i.rehashPassword(ctx, staff.Id, req.Password)
+	}
+
+	if staff.Status == models.StaffInvited {
+		_, err = i.strg.Staff().ChangeStatus(ctx, &models.StaffStatusChange{
+			StaffId:     staff.Id,
+			From:        models.StaffInvited,
+			To:          models.StaffActive,
+			Reason:      "first login",
+			EffectiveAt: time.Now().UTC(),
+		})
+		if err != nil {
+			logger.FromContext(ctx).Error("!!!LoginStaff->Staff->ChangeStatus--->", logger.Error(err))
+			return nil, storageError(err, codes.Internal)
+		}
+	}
+
+	token, expiresAt, err := i.tokens.Issue(staff.Id, staff.MagazinId, staff.StaffType)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!LoginStaff->Issue--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	profile, err := i.strg.Staff().GetByID(ctx, &organization_service.StaffPK{Id: staff.Id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!LoginStaff->Staff->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	return &organization_service.StaffLoginResponse{
+		AccessToken: token,
+		ExpiresAt:   expiresAt.UTC().Format(time.RFC3339),
+		Staff:       profile,
+	}, nil
+}
+
+func (i *StaffService) ValidateToken(ctx context.Context, req *organization_service.ValidateStaffTokenRequest) (resp *organization_service.ValidateStaffTokenResponse, err error) {
+
+	claims, err := i.tokens.Parse(req.AccessToken)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	staff, err := i.strg.Staff().GetAuthByID(ctx, claims.Subject)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.Unauthenticated, auth.ErrInvalidToken.Error())
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ValidateStaffToken->Staff->GetAuthByID--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if err = checkStaffStatus(staff); err != nil {
+		return nil, err
+	}
+
+	return &organization_service.ValidateStaffTokenResponse{
+		StaffId:   staff.Id,
+		MagazinId: staff.MagazinId,
+		StaffType: staff.StaffType,
+		ExpiresAt: claims.ExpiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// RequestPasswordReset sends an OTP to the phone of the staff found by login
+// or phone. Unknown staff get the same responses as known ones, the rate
+// limit included, so the endpoint can't be used to find out who is
+// registered.
+func (i *StaffService) RequestPasswordReset(ctx context.Context, req *organization_service.RequestPasswordResetRequest) (resp *organization_service.RequestPasswordResetResponse, err error) {
+
+	now := time.Now().UTC()
+	resp = &organization_service.RequestPasswordResetResponse{
+		ResetId:   uuid.New().String(),
+		ExpiresAt: now.Add(i.cfg.OTPTTL).Format(time.RFC3339),
+	}
+
+	key := "login:" + req.Login
+	if req.Phone != "" {
+		if req.Phone, err = normalizePhone(i.cfg, req.Phone); err != nil {
+			return nil, err
+		}
+		key = "phone:" + req.Phone
+	}
+
+	count, last, err := i.strg.PasswordReset().CountRequestsSince(ctx, key, now.Add(-i.cfg.OTPRateWindow))
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RequestPasswordReset->PasswordReset->CountRequestsSince--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if count >= i.cfg.OTPRateLimit || now.Sub(last) < i.cfg.OTPResendInterval {
+		return nil, status.Error(codes.ResourceExhausted, "too many password reset requests, try again later")
+	}
+
+	if err = i.strg.PasswordReset().RecordRequest(ctx, key, now); err != nil {
+		logger.FromContext(ctx).Error("!!!RequestPasswordReset->PasswordReset->RecordRequest--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	var staff *models.StaffAuth
+	if req.Phone != "" {
+		staff, err = i.strg.Staff().GetAuthByPhone(ctx, req.Phone)
+	} else {
+		staff, err = i.strg.Staff().GetAuthByLogin(ctx, req.Login)
+	}
+	if errors.Is(err, storage.ErrNotFound) {
+		return resp, nil
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RequestPasswordReset->Staff->GetAuth--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if staff.Phone == "" || !models.StaffCanLogin(staff.EffectiveStatus(now)) {
+		return resp, nil
+	}
+
+	// a staff asked for by both login and phone still gets no more codes than
+	// the limit, silently, as the response must not tell it exists
+	count, last, err = i.strg.PasswordReset().CountSince(ctx, staff.Phone, now.Add(-i.cfg.OTPRateWindow))
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RequestPasswordReset->PasswordReset->CountSince--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if count >= i.cfg.OTPRateLimit || now.Sub(last) < i.cfg.OTPResendInterval {
+		return resp, nil
+	}
+
+	otp, err := helper.GenerateOTP(i.cfg.OTPLength)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RequestPasswordReset->GenerateOTP--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	otpHash, err := auth.HashPassword(otp)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RequestPasswordReset->HashPassword--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = i.strg.PasswordReset().Create(ctx, &models.PasswordReset{
+		Id:        resp.ResetId,
+		StaffId:   staff.Id,
+		Phone:     staff.Phone,
+		OtpHash:   otpHash,
+		ExpiresAt: now.Add(i.cfg.OTPTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RequestPasswordReset->PasswordReset->Create--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	// a failed delivery is only logged, answering it would tell the staff exists
+	err = i.sms.Send(ctx, staff.Phone, "Your password reset code: "+otp)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!RequestPasswordReset->Send--->", logger.Error(err))
+	}
+
+	return resp, nil
+}
+
+// ConfirmPasswordReset sets the new password if otp matches the one sent
+// for the reset. A reset can be used once and only within
+// cfg.OTPMaxAttempts tries.
+func (i *StaffService) ConfirmPasswordReset(ctx context.Context, req *organization_service.ConfirmPasswordResetRequest) (resp *empty.Empty, err error) {
+
+	reset, err := i.strg.PasswordReset().GetByID(ctx, req.ResetId)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired code")
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ConfirmPasswordReset->PasswordReset->GetByID--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if reset.Consumed || time.Now().After(reset.ExpiresAt) {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired code")
+	}
+
+	// the attempt is taken before the code is compared, so concurrent tries
+	// can't exceed the limit
+	_, err = i.strg.PasswordReset().IncrementAttempts(ctx, reset.Id, i.cfg.OTPMaxAttempts)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Error(codes.ResourceExhausted, "too many attempts, request a new code")
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ConfirmPasswordReset->PasswordReset->IncrementAttempts--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if !auth.CheckPassword(reset.OtpHash, req.Otp) {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired code")
+	}
+
+	staff, err := i.strg.Staff().GetAuthByID(ctx, reset.StaffId)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ConfirmPasswordReset->Staff->GetAuthByID--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	if err = checkStaffStatus(staff); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := auth.HashPassword(req.NewPassword)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ConfirmPasswordReset->HashPassword--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rowsAffected, err := i.strg.PasswordReset().Consume(ctx, reset.Id, reset.StaffId, passwordHash)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ConfirmPasswordReset->PasswordReset->Consume--->", logger.Error(err))
+		return nil, storageError(err, codes.Internal)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired code")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// registerLoginFailure counts a wrong password of the staff and locks it out
+// after cfg.LoginMaxFailures in a row, for longer with every lockout.
+func (i *StaffService) registerLoginFailure(ctx context.Context, id, clientIP string) {
+	log := logger.FromContext(ctx)
+
+	failedLogins, lockouts, err := i.strg.Staff().RegisterLoginFailure(ctx, id)
+	if err != nil {
+		log.Error("!!!LoginStaff->Staff->RegisterLoginFailure--->", logger.Error(err))
+		return
+	}
+
+	if failedLogins < i.cfg.LoginMaxFailures {
+		return
+	}
+
+	lockedUntil := time.Now().UTC().Add(models.LockoutDuration(lockouts, i.cfg.LoginLockoutBase, i.cfg.LoginLockoutMax))
+
+	if _, err = i.strg.Staff().Lock(ctx, id, lockedUntil); err != nil {
+		log.Error("!!!LoginStaff->Staff->Lock--->", logger.Error(err))
+		return
+	}
+
+	log.Warn("SECURITY: staff locked out after failed logins",
+		logger.String("staff_id", id),
+		logger.String("client_ip", clientIP),
+		logger.Int("failed_logins", failedLogins),
+		logger.String("locked_until", lockedUntil.Format(time.RFC3339)),
+	)
+
+	staff, err := i.strg.Staff().GetByID(ctx, &organization_service.StaffPK{Id: id})
+	if err != nil {
+		log.Error("!!!LoginStaff->Staff->Get--->", logger.Error(err))
+		return
+	}
+
+	i.publishStaffEvent(ctx, webhook.EventStaffLocked, staff)
+}
+
+// recordLoginAttempt saves the attempt for the per client IP limit. Failing
+// to do so doesn't fail the login.
+func (i *StaffService) recordLoginAttempt(ctx context.Context, attempt *models.LoginAttempt) {
+	if err := i.strg.LoginAttempt().Create(ctx, attempt); err != nil {
+		logger.FromContext(ctx).Error("!!!LoginStaff->LoginAttempt->Create--->", logger.Error(err))
+	}
+}
+
+// rehashPassword replaces a password stored before hashing was introduced.
+// Failing to do so doesn't fail the login, it is retried on the next one.
+func (i *StaffService) rehashPassword(ctx context.Context, id, password string) {
+	hash, err := auth.HashPassword(password)
+	if err == nil {
+		_, err = i.strg.Staff().UpdatePassword(ctx, id, hash)
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!LoginStaff->rehashPassword--->", logger.Error(err))
+	}
+}
+
+// checkStaffStatus returns PermissionDenied unless the status of the staff
+// in effect now allows logging in.
+func checkStaffStatus(staff *models.StaffAuth) error {
+	if current := staff.EffectiveStatus(time.Now().UTC()); !models.StaffCanLogin(current) {
+		return status.Errorf(codes.PermissionDenied, "staff is %s", current)
+	}
+
+	return nil
+}
+
+// publishStaffEvent publishes a staff event scoped to the filial of the
+// staff's magazin. The password is never sent to subscribers.
+func (i *StaffService) publishStaffEvent(ctx context.Context, eventType string, staff *organization_service.Staff) {
+	data := proto.Clone(staff).(*organization_service.Staff)
+	data.Password = ""
+
+	publishEvent(ctx, i.strg, eventType, magazinFilialID(ctx, i.strg, staff.MagazinId), data)
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"organization_service/config"
+	"organization_service/genproto/organization_service"
+	"organization_service/grpc/client"
+	"organization_service/pkg/logger"
+	"organization_service/pkg/webhook"
+	"organization_service/storage"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WebhookService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	*organization_service.UnimplementedWebhookServiceServer
+}
+
+func NewWebhookService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, srvs client.ServiceManagerI) *WebhookService {
+	return &WebhookService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: srvs,
+	}
+}
+
+func (i *WebhookService) Create(ctx context.Context, req *organization_service.CreateWebhook) (resp *organization_service.Webhook, err error) {
+
+	if err = validateWebhook(req.GetEventType(), req.GetUrl()); err != nil {
+		return nil, err
+	}
+
+	if req.Secret == "" {
+		req.Secret, err = generateWebhookSecret()
+		if err != nil {
+			logger.FromContext(ctx).Error("!!!CreateWebhook->generateWebhookSecret--->", logger.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	pKey, err := i.strg.Webhook().Create(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!CreateWebhook->Webhook->Create--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	resp, err = i.strg.Webhook().GetByID(ctx, pKey)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetByPKeyWebhook->Webhook->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	// the secret is shown once, reads leave it out
+	resp.Secret = req.Secret
+
+	return
+}
+
+func (i *WebhookService) GetByID(ctx context.Context, req *organization_service.WebhookPK) (resp *organization_service.Webhook, err error) {
+
+	resp, err = i.strg.Webhook().GetByID(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetWebhookByID->Webhook->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *WebhookService) GetList(ctx context.Context, req *organization_service.GetListWebhookRequest) (resp *organization_service.GetListWebhookResponse, err error) {
+
+	resp, err = i.strg.Webhook().GetList(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetWebhooks->Webhook->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *WebhookService) Update(ctx context.Context, req *organization_service.UpdateWebhook) (resp *organization_service.Webhook, err error) {
+
+	if err = validateWebhook(req.GetEventType(), req.GetUrl()); err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := i.strg.Webhook().Update(ctx, req)
+
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!UpdateWebhook--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	resp, err = i.strg.Webhook().GetByID(ctx, &organization_service.WebhookPK{Id: req.Id})
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetWebhook->Webhook->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	return resp, err
+}
+
+func (i *WebhookService) Delete(ctx context.Context, req *organization_service.WebhookPK) (resp *empty.Empty, err error) {
+
+	err = i.strg.Webhook().Delete(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!DeleteWebhook->Webhook->Get--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (i *WebhookService) GetDeliveryList(ctx context.Context, req *organization_service.GetListWebhookDeliveryRequest) (resp *organization_service.GetListWebhookDeliveryResponse, err error) {
+
+	resp, err = i.strg.Webhook().GetDeliveryList(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!GetWebhookDeliveries->Webhook->GetDeliveryList--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	return
+}
+
+func (i *WebhookService) ReplayDelivery(ctx context.Context, req *organization_service.WebhookDeliveryPK) (resp *organization_service.WebhookDelivery, err error) {
+
+	rowsAffected, err := i.strg.Webhook().ReplayDelivery(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ReplayWebhookDelivery->Webhook->ReplayDelivery--->", logger.Error(err))
+		return nil, storageError(err, codes.InvalidArgument)
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "webhook delivery not found")
+	}
+
+	resp, err = i.strg.Webhook().GetDeliveryByID(ctx, req)
+	if err != nil {
+		logger.FromContext(ctx).Error("!!!ReplayWebhookDelivery->Webhook->GetDeliveryByID--->", logger.Error(err))
+		return nil, storageError(err, codes.NotFound)
+	}
+
+	return
+}
+
+func validateWebhook(eventType, rawURL string) error {
+	if !webhook.IsKnownEvent(eventType) {
+		return status.Errorf(codes.InvalidArgument, "unknown event type %q", eventType)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid webhook url %q", rawURL)
+	}
+
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	buffer := make([]byte, 32)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buffer), nil
+}
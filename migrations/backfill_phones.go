@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"organization_service/config"
+	"organization_service/pkg/phone"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// phoneTables are the tables BackfillPhones normalizes.
+var phoneTables = []string{"filial", "staff", "provider"}
+
+// BackfillPhones rewrites the phone numbers stored before normalization was
+// introduced to E.164. Numbers that can't be parsed are reported and left as
+// they are. With dryRun set nothing is written.
+func BackfillPhones(ctx context.Context, cfg config.Config, w io.Writer, dryRun bool) error {
+	conn, err := pgx.Connect(ctx, databaseURL(cfg, "postgres"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	for _, table := range phoneTables {
+		if err = backfillTablePhones(ctx, conn, cfg, w, table, dryRun); err != nil {
+			return fmt.Errorf("backfill %s phones: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+func backfillTablePhones(ctx context.Context, conn *pgx.Conn, cfg config.Config, w io.Writer, table string, dryRun bool) error {
+	rows, err := conn.Query(ctx, `SELECT id::TEXT, phone FROM "`+table+`"`)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]string{}
+	invalid := 0
+
+	for rows.Next() {
+		var id, raw string
+		if err = rows.Scan(&id, &raw); err != nil {
+			rows.Close()
+			return err
+		}
+
+		normalized, err := phone.Normalize(raw, cfg.PhoneDefaultCountryCode)
+		if err != nil {
+			invalid++
+			fmt.Fprintf(w, "%s %s: %v\n", table, id, err)
+			continue
+		}
+
+		if normalized != raw {
+			updates[id] = normalized
+		}
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if !dryRun {
+		for id, normalized := range updates {
+			if _, err = conn.Exec(ctx, `UPDATE "`+table+`" SET phone = $1 WHERE id = $2`, normalized, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	fmt.Fprintf(w, "%s: %d normalized, %d invalid\n", table, len(updates), invalid)
+
+	return nil
+}
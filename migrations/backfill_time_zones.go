@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"organization_service/config"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// BackfillTimeZones sets the time zone of the filials that have none, those
+// created before time zones were introduced, to cfg.FilialDefaultTimeZone.
+// The schema must be migrated to the version adding time zones.
+func BackfillTimeZones(ctx context.Context, cfg config.Config, w io.Writer) error {
+	conn, err := pgx.Connect(ctx, databaseURL(cfg, "postgres"))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	result, err := conn.Exec(ctx, `UPDATE "filial" SET time_zone = $1 WHERE time_zone = ''`, cfg.FilialDefaultTimeZone)
+	if err != nil {
+		return fmt.Errorf("backfill filial time zones: %w", err)
+	}
+
+	fmt.Fprintf(w, "filial: %d time zones set to %s\n", result.RowsAffected(), cfg.FilialDefaultTimeZone)
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package migrations
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"organization_service/config"
+	"sort"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/pgx"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+//go:embed postgres/*.sql
+var postgresFS embed.FS
+
+// ErrVersionMismatch is returned by Check when the schema of the database is
+// not the one the binary was built for.
+var ErrVersionMismatch = errors.New("database schema version mismatch")
+
+// New returns a migrate instance applying the embedded postgres migrations
+// to the database of cfg.
+func New(cfg config.Config) (*migrate.Migrate, error) {
+	src, err := iofs.New(postgresFS, "postgres")
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewWithSourceInstance("iofs", src, databaseURL(cfg, "pgx"))
+}
+
+func databaseURL(cfg config.Config, scheme string) string {
+	dsn := url.URL{
+		Scheme:   scheme,
+		User:     url.UserPassword(cfg.PostgresUser, cfg.PostgresPassword),
+		Host:     fmt.Sprintf("%s:%d", cfg.PostgresHost, cfg.PostgresPort),
+		Path:     cfg.PostgresDatabase,
+		RawQuery: "sslmode=disable",
+	}
+
+	return dsn.String()
+}
+
+// Versions returns the versions of the embedded migrations in ascending order.
+func Versions() ([]uint, error) {
+	files, err := fs.Glob(postgresFS, "postgres/*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]uint, 0, len(files))
+	for _, file := range files {
+		m, err := source.DefaultParse(file[len("postgres/"):])
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, m.Version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	return versions, nil
+}
+
+// ExpectedVersion returns the schema version the code expects, that is the
+// version of the latest embedded migration.
+func ExpectedVersion() (uint, error) {
+	versions, err := Versions()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(versions) == 0 {
+		return 0, nil
+	}
+
+	return versions[len(versions)-1], nil
+}
+
+// Check makes sure the database schema is at ExpectedVersion. An older schema
+// is migrated up when autoMigrate is set, any other mismatch and a dirty
+// schema are reported as ErrVersionMismatch.
+func Check(cfg config.Config, autoMigrate bool) error {
+	expected, err := ExpectedVersion()
+	if err != nil {
+		return err
+	}
+
+	m, err := New(cfg)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	version, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return err
+	}
+
+	switch {
+	case dirty:
+		return fmt.Errorf("%w: version %d is dirty, fix it and run migrate force", ErrVersionMismatch, version)
+	case version == expected:
+		return nil
+	case version < expected && autoMigrate:
+		if err = m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return err
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: database is at version %d, expected %d", ErrVersionMismatch, version, expected)
+	}
+}
+
+// Run executes the migrate subcommand described by args and writes its
+// output to w:
+//
+//	up            apply all pending migrations
+//	down [N]      roll back N migrations, 1 by default
+//	status        print the current and expected versions
+//	force V       set the version without running migrations
+//	backfill-phones [--dry-run]
+//	              normalize the stored phone numbers to E.164
+//	backfill-time-zones
+//	              set the default time zone of filials without one
+func Run(cfg config.Config, w io.Writer, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up|down [N]|status|force V|backfill-phones [--dry-run]|backfill-time-zones")
+	}
+
+	switch args[0] {
+	case "backfill-phones":
+		return BackfillPhones(context.Background(), cfg, w, len(args) > 1 && args[1] == "--dry-run")
+	case "backfill-time-zones":
+		return BackfillTimeZones(context.Background(), cfg, w)
+	}
+
+	m, err := New(cfg)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	switch args[0] {
+	case "up":
+		err = m.Up()
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		err = m.Steps(-steps)
+	case "force":
+		if len(args) < 2 {
+			return errors.New("usage: migrate force V")
+		}
+		version, convErr := strconv.Atoi(args[1])
+		if convErr != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		err = m.Force(version)
+	case "status":
+		return status(m, w)
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
+
+	if errors.Is(err, migrate.ErrNoChange) {
+		fmt.Fprintln(w, "no change")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return status(m, w)
+}
+
+func status(m *migrate.Migrate, w io.Writer) error {
+	expected, err := ExpectedVersion()
+	if err != nil {
+		return err
+	}
+
+	version, dirty, err := m.Version()
+	switch {
+	case errors.Is(err, migrate.ErrNilVersion):
+		fmt.Fprintf(w, "version: none, expected: %d\n", expected)
+	case err != nil:
+		return err
+	default:
+		fmt.Fprintf(w, "version: %d, dirty: %t, expected: %d\n", version, dirty, expected)
+	}
+
+	return nil
+}
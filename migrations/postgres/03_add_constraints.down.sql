@@ -0,0 +1,27 @@
+DROP TRIGGER IF EXISTS webhook_delivery_set_updated_at ON "webhook_delivery";
+DROP TRIGGER IF EXISTS webhook_set_updated_at ON "webhook";
+DROP TRIGGER IF EXISTS provider_set_updated_at ON "provider";
+DROP TRIGGER IF EXISTS staff_set_updated_at ON "staff";
+DROP TRIGGER IF EXISTS magazin_set_updated_at ON "magazin";
+DROP TRIGGER IF EXISTS filial_set_updated_at ON "filial";
+
+DROP FUNCTION IF EXISTS set_updated_at();
+
+ALTER TABLE "webhook_delivery" ALTER COLUMN updated_at DROP DEFAULT;
+ALTER TABLE "webhook" ALTER COLUMN updated_at DROP DEFAULT;
+ALTER TABLE "provider" ALTER COLUMN updated_at DROP DEFAULT;
+ALTER TABLE "staff" ALTER COLUMN updated_at DROP DEFAULT;
+ALTER TABLE "magazin" ALTER COLUMN updated_at DROP DEFAULT;
+ALTER TABLE "filial" ALTER COLUMN updated_at DROP DEFAULT;
+
+ALTER TABLE "webhook_delivery" DROP CONSTRAINT IF EXISTS webhook_delivery_status_check;
+
+ALTER TABLE "provider" DROP CONSTRAINT IF EXISTS provider_status_check;
+ALTER TABLE "provider" ALTER COLUMN status DROP NOT NULL;
+
+DROP INDEX IF EXISTS webhook_delivery_webhook_id_idx;
+DROP INDEX IF EXISTS staff_magazin_id_idx;
+DROP INDEX IF EXISTS magazin_filial_id_idx;
+
+ALTER TABLE "staff" DROP CONSTRAINT IF EXISTS staff_login_key;
+ALTER TABLE "filial" DROP CONSTRAINT IF EXISTS filial_filial_code_key;
@@ -0,0 +1,69 @@
+-- The old generator left empty and colliding filial codes. The oldest filial
+-- keeps a code, the others get one derived from their id.
+UPDATE "filial" SET filial_code = 'FL-' || UPPER(REPLACE(id::text, '-', ''))
+WHERE id IN (
+    SELECT id FROM (
+        SELECT
+            id,
+            filial_code,
+            ROW_NUMBER() OVER (PARTITION BY filial_code ORDER BY created_at, id) AS n
+        FROM "filial"
+    ) f
+    WHERE f.filial_code = '' OR f.n > 1
+);
+
+-- The oldest staff keeps a duplicated login, the others get the start of
+-- their id appended and have to be told their new login.
+UPDATE "staff" SET login = LEFT(login, 41) || '-' || LEFT(id::text, 8)
+WHERE id IN (
+    SELECT id FROM (
+        SELECT
+            id,
+            ROW_NUMBER() OVER (PARTITION BY login ORDER BY created_at, id) AS n
+        FROM "staff"
+    ) s
+    WHERE s.n > 1
+);
+
+ALTER TABLE "filial" ADD CONSTRAINT filial_filial_code_key UNIQUE (filial_code);
+ALTER TABLE "staff" ADD CONSTRAINT staff_login_key UNIQUE (login);
+
+CREATE INDEX IF NOT EXISTS magazin_filial_id_idx ON "magazin" (filial_id);
+CREATE INDEX IF NOT EXISTS staff_magazin_id_idx ON "staff" (magazin_id);
+CREATE INDEX IF NOT EXISTS webhook_delivery_webhook_id_idx ON "webhook_delivery" (webhook_id);
+
+-- status accepted any smallint, providers out of range become free
+UPDATE "provider" SET status = 0 WHERE status IS NULL OR status NOT IN (0, 1);
+ALTER TABLE "provider" ALTER COLUMN status SET NOT NULL;
+--0 free, -- 1 working
+ALTER TABLE "provider" ADD CONSTRAINT provider_status_check CHECK (status IN (0, 1));
+
+ALTER TABLE "webhook_delivery" ADD CONSTRAINT webhook_delivery_status_check CHECK (status IN ('pending', 'delivered', 'dead'));
+
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+UPDATE "filial" SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE "magazin" SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE "staff" SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE "provider" SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE "webhook" SET updated_at = created_at WHERE updated_at IS NULL;
+UPDATE "webhook_delivery" SET updated_at = created_at WHERE updated_at IS NULL;
+
+ALTER TABLE "filial" ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "magazin" ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "staff" ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "provider" ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "webhook" ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE "webhook_delivery" ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+
+CREATE TRIGGER filial_set_updated_at BEFORE UPDATE ON "filial" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER magazin_set_updated_at BEFORE UPDATE ON "magazin" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER staff_set_updated_at BEFORE UPDATE ON "staff" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER provider_set_updated_at BEFORE UPDATE ON "provider" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER webhook_set_updated_at BEFORE UPDATE ON "webhook" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER webhook_delivery_set_updated_at BEFORE UPDATE ON "webhook_delivery" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
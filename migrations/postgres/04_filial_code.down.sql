@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "filial_code_counter";
+
+ALTER TABLE "filial" DROP COLUMN IF EXISTS region;
@@ -0,0 +1,6 @@
+ALTER TABLE "filial" ADD COLUMN IF NOT EXISTS region VARCHAR(20) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS "filial_code_counter"(
+    key VARCHAR(50) PRIMARY KEY,
+    value BIGINT NOT NULL
+);
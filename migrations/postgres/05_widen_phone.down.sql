@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS provider_phone_idx;
+DROP INDEX IF EXISTS staff_phone_idx;
+
+-- The phone columns stay VARCHAR(16): Uzbek numbers fit in the old 13
+-- characters, but foreign ones stored since can have up to 15 digits after
+-- the plus, and the wider columns accept every older number.
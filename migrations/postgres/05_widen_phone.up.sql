@@ -0,0 +1,7 @@
+-- E.164 numbers have up to 15 digits after the plus
+ALTER TABLE "filial" ALTER COLUMN phone TYPE VARCHAR(16);
+ALTER TABLE "staff" ALTER COLUMN phone TYPE VARCHAR(16);
+ALTER TABLE "provider" ALTER COLUMN phone TYPE VARCHAR(16);
+
+CREATE INDEX IF NOT EXISTS staff_phone_idx ON "staff" (phone);
+CREATE INDEX IF NOT EXISTS provider_phone_idx ON "provider" (phone);
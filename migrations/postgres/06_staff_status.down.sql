@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "staff_status_history";
+
+ALTER TABLE "staff" DROP COLUMN IF EXISTS status_effective_at;
+ALTER TABLE "staff" DROP COLUMN IF EXISTS status_reason;
+ALTER TABLE "staff" DROP COLUMN IF EXISTS previous_status;
+ALTER TABLE "staff" DROP CONSTRAINT IF EXISTS staff_status_check;
+ALTER TABLE "staff" DROP COLUMN IF EXISTS status;
@@ -0,0 +1,25 @@
+-- bcrypt hashes are 60 characters long
+ALTER TABLE "staff" ALTER COLUMN password TYPE VARCHAR(100);
+
+-- staff created before the lifecycle existed are active
+ALTER TABLE "staff" ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active';
+ALTER TABLE "staff" ALTER COLUMN status SET DEFAULT 'invited';
+ALTER TABLE "staff" ADD CONSTRAINT staff_status_check CHECK (status IN ('invited', 'active', 'suspended', 'terminated'));
+
+-- the status before the last change, in effect until status_effective_at
+ALTER TABLE "staff" ADD COLUMN IF NOT EXISTS previous_status VARCHAR(20);
+ALTER TABLE "staff" ADD COLUMN IF NOT EXISTS status_reason VARCHAR(255);
+ALTER TABLE "staff" ADD COLUMN IF NOT EXISTS status_effective_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS "staff_status_history"(
+    id UUID PRIMARY KEY,
+    staff_id UUID NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    reason VARCHAR(255),
+    effective_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (staff_id) REFERENCES "staff" (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS staff_status_history_staff_id_idx ON "staff_status_history" (staff_id);
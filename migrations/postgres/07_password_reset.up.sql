@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "password_reset"(
+    id UUID PRIMARY KEY,
+    staff_id UUID NOT NULL,
+    phone VARCHAR(16) NOT NULL,
+    otp_hash VARCHAR(100) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    consumed_at TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (staff_id) REFERENCES "staff" (id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS password_reset_phone_created_at_idx ON "password_reset" (phone, created_at);
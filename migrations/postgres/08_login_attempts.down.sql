@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "login_attempt";
+
+ALTER TABLE "staff" DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE "staff" DROP COLUMN IF EXISTS lockouts;
+ALTER TABLE "staff" DROP COLUMN IF EXISTS failed_logins;
@@ -0,0 +1,18 @@
+-- failed logins in a row and how often the staff was locked out since its
+-- last successful login, the lockout doubles every time
+ALTER TABLE "staff" ADD COLUMN IF NOT EXISTS failed_logins INT NOT NULL DEFAULT 0;
+ALTER TABLE "staff" ADD COLUMN IF NOT EXISTS lockouts INT NOT NULL DEFAULT 0;
+ALTER TABLE "staff" ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS "login_attempt"(
+    id UUID PRIMARY KEY,
+    login VARCHAR(50) NOT NULL,
+    staff_id UUID,
+    client_ip VARCHAR(45),
+    success BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (staff_id) REFERENCES "staff" (id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS login_attempt_login_created_at_idx ON "login_attempt" (login, created_at);
+CREATE INDEX IF NOT EXISTS login_attempt_client_ip_created_at_idx ON "login_attempt" (client_ip, created_at);
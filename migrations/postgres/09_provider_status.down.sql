@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS "provider_status_history";
+
+-- active providers are working again (1), the others are folded into free
+-- (0)
+ALTER TABLE "provider" DROP CONSTRAINT IF EXISTS provider_status_check;
+UPDATE "provider" SET status = CASE WHEN status = 2 THEN 1 ELSE 0 END;
+ALTER TABLE "provider" ALTER COLUMN status SET DEFAULT 0;
+ALTER TABLE "provider" ADD CONSTRAINT provider_status_check CHECK (status IN (0, 1));
@@ -0,0 +1,21 @@
+-- 1 prospect, 2 active, 3 on hold, 4 blacklisted, 5 archived, the values of
+-- ProviderStatus; providers that were free (0) become prospects, working ones
+-- (1) stay active
+ALTER TABLE "provider" DROP CONSTRAINT IF EXISTS provider_status_check;
+UPDATE "provider" SET status = status + 1;
+ALTER TABLE "provider" ALTER COLUMN status SET DEFAULT 1;
+ALTER TABLE "provider" ADD CONSTRAINT provider_status_check CHECK (status BETWEEN 1 AND 5);
+
+CREATE TABLE IF NOT EXISTS "provider_status_history"(
+    id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    from_status SMALLINT NOT NULL,
+    to_status SMALLINT NOT NULL,
+    reason VARCHAR(255),
+    changed_by UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (provider_id) REFERENCES "provider" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (changed_by) REFERENCES "staff" (id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS provider_status_history_provider_id_idx ON "provider_status_history" (provider_id, created_at);
@@ -0,0 +1,11 @@
+ALTER TABLE "provider" DROP CONSTRAINT IF EXISTS provider_inn_key;
+ALTER TABLE "provider" DROP CONSTRAINT IF EXISTS provider_account_number_check;
+ALTER TABLE "provider" DROP CONSTRAINT IF EXISTS provider_mfo_check;
+ALTER TABLE "provider" DROP CONSTRAINT IF EXISTS provider_inn_check;
+
+ALTER TABLE "provider" DROP COLUMN IF EXISTS legal_address;
+ALTER TABLE "provider" DROP COLUMN IF EXISTS vat_payer;
+ALTER TABLE "provider" DROP COLUMN IF EXISTS account_number;
+ALTER TABLE "provider" DROP COLUMN IF EXISTS mfo;
+ALTER TABLE "provider" DROP COLUMN IF EXISTS inn;
+ALTER TABLE "provider" DROP COLUMN IF EXISTS legal_name;
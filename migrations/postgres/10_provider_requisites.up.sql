@@ -0,0 +1,12 @@
+ALTER TABLE "provider" ADD COLUMN IF NOT EXISTS legal_name VARCHAR(255);
+ALTER TABLE "provider" ADD COLUMN IF NOT EXISTS inn VARCHAR(9);
+ALTER TABLE "provider" ADD COLUMN IF NOT EXISTS mfo VARCHAR(5);
+ALTER TABLE "provider" ADD COLUMN IF NOT EXISTS account_number VARCHAR(20);
+ALTER TABLE "provider" ADD COLUMN IF NOT EXISTS vat_payer BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "provider" ADD COLUMN IF NOT EXISTS legal_address VARCHAR(255);
+
+-- the checksum of account numbers is checked by the service
+ALTER TABLE "provider" ADD CONSTRAINT provider_inn_check CHECK (inn ~ '^[1-9][0-9]{8}$');
+ALTER TABLE "provider" ADD CONSTRAINT provider_mfo_check CHECK (mfo ~ '^[0-9]{5}$');
+ALTER TABLE "provider" ADD CONSTRAINT provider_account_number_check CHECK (account_number ~ '^[0-9]{20}$' AND mfo IS NOT NULL);
+ALTER TABLE "provider" ADD CONSTRAINT provider_inn_key UNIQUE (inn);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS "provider_contact"(
+    id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    phone VARCHAR(16),
+    email VARCHAR(255),
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (provider_id) REFERENCES "provider" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT provider_contact_role_check CHECK (role IN ('sales', 'accountant', 'warehouse', 'other'))
+);
+
+CREATE INDEX IF NOT EXISTS provider_contact_provider_id_idx ON "provider_contact" (provider_id);
+CREATE UNIQUE INDEX IF NOT EXISTS provider_contact_primary_key ON "provider_contact" (provider_id) WHERE is_primary;
+
+CREATE TRIGGER provider_contact_set_updated_at BEFORE UPDATE ON "provider_contact" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "provider_magazin"(
+    id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    magazin_id UUID NOT NULL,
+    -- ISO weekdays, 1 is Monday and 7 Sunday
+    delivery_days INT[] NOT NULL DEFAULT '{}',
+    lead_time_days INT NOT NULL DEFAULT 0,
+    active_from DATE NOT NULL DEFAULT CURRENT_DATE,
+    active_to DATE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (provider_id) REFERENCES "provider" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (magazin_id) REFERENCES "magazin" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT provider_magazin_key UNIQUE (provider_id, magazin_id),
+    CONSTRAINT provider_magazin_delivery_days_check CHECK (delivery_days <@ ARRAY[1, 2, 3, 4, 5, 6, 7]),
+    CONSTRAINT provider_magazin_lead_time_days_check CHECK (lead_time_days >= 0),
+    CONSTRAINT provider_magazin_active_check CHECK (active_to IS NULL OR active_to >= active_from)
+);
+
+CREATE INDEX IF NOT EXISTS provider_magazin_magazin_id_idx ON "provider_magazin" (magazin_id);
+
+CREATE TRIGGER provider_magazin_set_updated_at BEFORE UPDATE ON "provider_magazin" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS "provider_product"(
+    id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    -- product of product_service, checked by the service as there is no foreign key
+    product_id UUID NOT NULL,
+    supplier_sku VARCHAR(64),
+    purchase_price NUMERIC(14, 2) NOT NULL,
+    min_order_qty INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (provider_id) REFERENCES "provider" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT provider_product_key UNIQUE (provider_id, product_id),
+    CONSTRAINT provider_product_purchase_price_check CHECK (purchase_price >= 0),
+    CONSTRAINT provider_product_min_order_qty_check CHECK (min_order_qty >= 1)
+);
+
+CREATE INDEX IF NOT EXISTS provider_product_product_id_idx ON "provider_product" (product_id);
+CREATE UNIQUE INDEX IF NOT EXISTS provider_product_supplier_sku_key ON "provider_product" (provider_id, supplier_sku) WHERE supplier_sku IS NOT NULL;
+
+CREATE TRIGGER provider_product_set_updated_at BEFORE UPDATE ON "provider_product" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
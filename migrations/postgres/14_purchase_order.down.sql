@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "purchase_order_item";
+DROP TABLE IF EXISTS "purchase_order";
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS "purchase_order"(
+    id UUID PRIMARY KEY,
+    order_number VARCHAR(20) NOT NULL,
+    magazin_id UUID NOT NULL,
+    provider_id UUID NOT NULL,
+    -- 0 draft, 1 submitted, 2 confirmed, 3 received, 4 cancelled
+    status SMALLINT NOT NULL DEFAULT 0,
+    expected_delivery_date DATE,
+    comment VARCHAR(255),
+    total_amount NUMERIC(16, 2) NOT NULL DEFAULT 0,
+    created_by UUID,
+    submitted_at TIMESTAMP,
+    confirmed_at TIMESTAMP,
+    received_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (magazin_id) REFERENCES "magazin" (id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    FOREIGN KEY (provider_id) REFERENCES "provider" (id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES "staff" (id) ON DELETE SET NULL ON UPDATE CASCADE,
+    CONSTRAINT purchase_order_order_number_key UNIQUE (order_number),
+    CONSTRAINT purchase_order_status_check CHECK (status BETWEEN 0 AND 4)
+);
+
+CREATE INDEX IF NOT EXISTS purchase_order_magazin_id_idx ON "purchase_order" (magazin_id, created_at);
+CREATE INDEX IF NOT EXISTS purchase_order_provider_id_idx ON "purchase_order" (provider_id, created_at);
+
+CREATE TRIGGER purchase_order_set_updated_at BEFORE UPDATE ON "purchase_order" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE IF NOT EXISTS "purchase_order_item"(
+    id UUID PRIMARY KEY,
+    purchase_order_id UUID NOT NULL,
+    -- product of product_service, checked by the service as there is no foreign key
+    product_id UUID NOT NULL,
+    quantity INT NOT NULL,
+    unit_price NUMERIC(14, 2) NOT NULL,
+    total NUMERIC(16, 2) GENERATED ALWAYS AS (quantity * unit_price) STORED,
+    FOREIGN KEY (purchase_order_id) REFERENCES "purchase_order" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT purchase_order_item_product_key UNIQUE (purchase_order_id, product_id),
+    CONSTRAINT purchase_order_item_quantity_check CHECK (quantity > 0),
+    CONSTRAINT purchase_order_item_unit_price_check CHECK (unit_price >= 0)
+);
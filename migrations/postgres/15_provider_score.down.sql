@@ -0,0 +1,3 @@
+DROP FUNCTION IF EXISTS provider_score(BIGINT, BIGINT, BIGINT, BIGINT, BIGINT, NUMERIC);
+DROP TABLE IF EXISTS "provider_rating";
+DROP TABLE IF EXISTS "provider_delivery";
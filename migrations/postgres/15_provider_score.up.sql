@@ -0,0 +1,74 @@
+CREATE TABLE IF NOT EXISTS "provider_delivery"(
+    id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    purchase_order_id UUID,
+    delivered_on DATE NOT NULL DEFAULT CURRENT_DATE,
+    days_late INT NOT NULL DEFAULT 0,
+    short_shipped BOOLEAN NOT NULL DEFAULT FALSE,
+    quality_issue BOOLEAN NOT NULL DEFAULT FALSE,
+    comment VARCHAR(255),
+    recorded_by UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (provider_id) REFERENCES "provider" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (purchase_order_id) REFERENCES "purchase_order" (id) ON DELETE SET NULL ON UPDATE CASCADE,
+    FOREIGN KEY (recorded_by) REFERENCES "staff" (id) ON DELETE SET NULL ON UPDATE CASCADE,
+    CONSTRAINT provider_delivery_purchase_order_key UNIQUE (purchase_order_id),
+    CONSTRAINT provider_delivery_days_late_check CHECK (days_late >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS provider_delivery_provider_id_idx ON "provider_delivery" (provider_id, delivered_on);
+
+CREATE TABLE IF NOT EXISTS "provider_rating"(
+    id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    rating SMALLINT NOT NULL,
+    comment VARCHAR(255),
+    -- NULL only once the staff is deleted
+    rated_by UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (provider_id) REFERENCES "provider" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (rated_by) REFERENCES "staff" (id) ON DELETE SET NULL ON UPDATE CASCADE,
+    CONSTRAINT provider_rating_rated_by_key UNIQUE (provider_id, rated_by),
+    CONSTRAINT provider_rating_rating_check CHECK (rating BETWEEN 1 AND 5)
+);
+
+CREATE INDEX IF NOT EXISTS provider_rating_provider_id_idx ON "provider_rating" (provider_id, updated_at);
+
+CREATE TRIGGER provider_rating_set_updated_at BEFORE UPDATE ON "provider_rating" FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- provider_score is the 0 to 100 score of the deliveries and the ratings of
+-- a provider in a period, NULL if it has neither. Deliveries weigh 80% and
+-- ratings 20%; on time deliveries count for half of the delivery part,
+-- complete and quality ones for a quarter each.
+CREATE OR REPLACE FUNCTION provider_score(
+    deliveries BIGINT,
+    on_time BIGINT,
+    short_shipped BIGINT,
+    quality_issues BIGINT,
+    ratings BIGINT,
+    average_rating NUMERIC
+) RETURNS NUMERIC AS $$
+DECLARE
+    delivery_score NUMERIC;
+    rating_score NUMERIC;
+BEGIN
+    IF deliveries > 0 THEN
+        delivery_score := 100 * (0.5 * on_time + 0.25 * (deliveries - short_shipped) + 0.25 * (deliveries - quality_issues)) / deliveries;
+    END IF;
+
+    IF ratings > 0 THEN
+        rating_score := 25 * (average_rating - 1);
+    END IF;
+
+    IF delivery_score IS NULL THEN
+        RETURN ROUND(rating_score, 2);
+    END IF;
+
+    IF rating_score IS NULL THEN
+        RETURN ROUND(delivery_score, 2);
+    END IF;
+
+    RETURN ROUND(0.8 * delivery_score + 0.2 * rating_score, 2);
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
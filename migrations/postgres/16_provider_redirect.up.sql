@@ -0,0 +1,12 @@
+-- provider_redirect keeps the ids of providers merged into another one so
+-- they keep resolving to the provider that survived the merge.
+CREATE TABLE IF NOT EXISTS "provider_redirect"(
+    old_id UUID PRIMARY KEY,
+    provider_id UUID NOT NULL,
+    merged_by UUID,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (provider_id) REFERENCES "provider" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (merged_by) REFERENCES "staff" (id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS provider_redirect_provider_id_idx ON "provider_redirect" (provider_id);
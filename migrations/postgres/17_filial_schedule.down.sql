@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "schedule_exception";
+DROP TABLE IF EXISTS "working_hours";
+ALTER TABLE "filial" DROP COLUMN IF EXISTS time_zone;
@@ -0,0 +1,47 @@
+-- Existing filials are left without a time zone and follow
+-- FILIAL_DEFAULT_TIME_ZONE until "migrate backfill-time-zones" stores it, new
+-- filials get it from FilialService.Create.
+ALTER TABLE "filial" ADD COLUMN IF NOT EXISTS time_zone VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE "filial" ALTER COLUMN time_zone DROP DEFAULT;
+
+-- working_hours is the weekly schedule of a filial, or of a magazin
+-- overriding the schedule of its filial.
+CREATE TABLE IF NOT EXISTS "working_hours"(
+    id UUID PRIMARY KEY,
+    filial_id UUID,
+    magazin_id UUID,
+    -- ISO weekday, 1 is Monday and 7 Sunday
+    weekday SMALLINT NOT NULL,
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    FOREIGN KEY (filial_id) REFERENCES "filial" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (magazin_id) REFERENCES "magazin" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT working_hours_owner_check CHECK ((filial_id IS NULL) <> (magazin_id IS NULL)),
+    CONSTRAINT working_hours_weekday_check CHECK (weekday BETWEEN 1 AND 7),
+    CONSTRAINT working_hours_interval_check CHECK (closes_at > opens_at)
+);
+
+CREATE INDEX IF NOT EXISTS working_hours_filial_id_idx ON "working_hours" (filial_id) WHERE filial_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS working_hours_magazin_id_idx ON "working_hours" (magazin_id) WHERE magazin_id IS NOT NULL;
+
+-- schedule_exception replaces the weekly hours of a date, closed when
+-- opens_at is NULL.
+CREATE TABLE IF NOT EXISTS "schedule_exception"(
+    id UUID PRIMARY KEY,
+    filial_id UUID,
+    magazin_id UUID,
+    date DATE NOT NULL,
+    opens_at TIME,
+    closes_at TIME,
+    reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (filial_id) REFERENCES "filial" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    FOREIGN KEY (magazin_id) REFERENCES "magazin" (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT schedule_exception_owner_check CHECK ((filial_id IS NULL) <> (magazin_id IS NULL)),
+    CONSTRAINT schedule_exception_interval_check CHECK (
+        (opens_at IS NULL AND closes_at IS NULL) OR closes_at > opens_at
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS schedule_exception_filial_date_key ON "schedule_exception" (filial_id, date) WHERE filial_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS schedule_exception_magazin_date_key ON "schedule_exception" (magazin_id, date) WHERE magazin_id IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS filial_geography_idx;
+DROP INDEX IF EXISTS filial_location_idx;
+ALTER TABLE "filial" DROP CONSTRAINT IF EXISTS filial_location_check;
+ALTER TABLE "filial" DROP COLUMN IF EXISTS longitude;
+ALTER TABLE "filial" DROP COLUMN IF EXISTS latitude;
+ALTER TABLE "filial" DROP COLUMN IF EXISTS street;
+ALTER TABLE "filial" DROP COLUMN IF EXISTS district;
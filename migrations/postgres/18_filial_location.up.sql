@@ -0,0 +1,21 @@
+ALTER TABLE "filial" ADD COLUMN IF NOT EXISTS district VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE "filial" ADD COLUMN IF NOT EXISTS street VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE "filial" ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE "filial" ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+ALTER TABLE "filial" ADD CONSTRAINT filial_location_check CHECK (
+    (latitude IS NULL AND longitude IS NULL)
+    OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+);
+
+CREATE INDEX IF NOT EXISTS filial_location_idx ON "filial" (latitude, longitude) WHERE latitude IS NOT NULL;
+
+-- with PostGIS installed nearest filials are searched by geography, without
+-- it the service falls back to the index above
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'postgis') THEN
+        EXECUTE 'CREATE INDEX IF NOT EXISTS filial_geography_idx ON "filial" USING GIST ((geography(ST_MakePoint(longitude, latitude)))) WHERE latitude IS NOT NULL';
+    END IF;
+END
+$$;
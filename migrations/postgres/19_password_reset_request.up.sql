@@ -0,0 +1,9 @@
+-- every password reset request by the login or phone it asked for, found or
+-- not, so that the rate limit doesn't tell registered staff apart
+CREATE TABLE IF NOT EXISTS "password_reset_request"(
+    id UUID PRIMARY KEY,
+    key VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS password_reset_request_key_created_at_idx ON "password_reset_request" (key, created_at);
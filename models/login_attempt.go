@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// LoginAttempt is a staff login, successful or not. StaffId is empty if no
+// staff has the login.
+type LoginAttempt struct {
+	Id        string
+	Login     string
+	StaffId   string
+	ClientIP  string
+	Success   bool
+	CreatedAt time.Time
+}
+
+// LockoutDuration returns how long a staff is locked out after it was
+// already locked out lockouts times: base, doubled with every lockout, but
+// never longer than max.
+func LockoutDuration(lockouts int, base, max time.Duration) time.Duration {
+	d := base
+	for ; lockouts > 0 && d < max; lockouts-- {
+		d *= 2
+	}
+
+	if d > max {
+		return max
+	}
+
+	return d
+}
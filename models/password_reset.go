@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// PasswordReset is a pending password reset of a staff, confirmed with an
+// OTP sent to the staff's phone.
+type PasswordReset struct {
+	Id        string
+	StaffId   string
+	Phone     string
+	OtpHash   string
+	Attempts  int
+	Consumed  bool
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,85 @@
+package models
+
+import "organization_service/genproto/organization_service"
+
+// providerTransitions lists the statuses a provider can move to from each
+// status. A blacklisted provider is put on hold before it can work again.
+var providerTransitions = map[organization_service.ProviderStatus][]organization_service.ProviderStatus{
+	organization_service.ProviderStatus_PROVIDER_STATUS_PROSPECT: {
+		organization_service.ProviderStatus_PROVIDER_STATUS_ACTIVE,
+		organization_service.ProviderStatus_PROVIDER_STATUS_BLACKLISTED,
+		organization_service.ProviderStatus_PROVIDER_STATUS_ARCHIVED,
+	},
+	organization_service.ProviderStatus_PROVIDER_STATUS_ACTIVE: {
+		organization_service.ProviderStatus_PROVIDER_STATUS_ON_HOLD,
+		organization_service.ProviderStatus_PROVIDER_STATUS_BLACKLISTED,
+		organization_service.ProviderStatus_PROVIDER_STATUS_ARCHIVED,
+	},
+	organization_service.ProviderStatus_PROVIDER_STATUS_ON_HOLD: {
+		organization_service.ProviderStatus_PROVIDER_STATUS_ACTIVE,
+		organization_service.ProviderStatus_PROVIDER_STATUS_BLACKLISTED,
+		organization_service.ProviderStatus_PROVIDER_STATUS_ARCHIVED,
+	},
+	organization_service.ProviderStatus_PROVIDER_STATUS_BLACKLISTED: {
+		organization_service.ProviderStatus_PROVIDER_STATUS_ON_HOLD,
+	},
+	organization_service.ProviderStatus_PROVIDER_STATUS_ARCHIVED: {
+		organization_service.ProviderStatus_PROVIDER_STATUS_PROSPECT,
+	},
+}
+
+// CanChangeProviderStatus reports whether a provider in status from can be
+// moved to status to.
+func CanChangeProviderStatus(from, to organization_service.ProviderStatus) bool {
+	for _, status := range providerTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ProviderStatusChange moves a provider from status From to To. ChangedBy is
+// the id of the staff making the change, if known.
+type ProviderStatusChange struct {
+	ProviderId string
+	From       organization_service.ProviderStatus
+	To         organization_service.ProviderStatus
+	Reason     string
+	ChangedBy  string
+}
+
+// Roles of provider contacts.
+const (
+	ProviderContactSales      = "sales"
+	ProviderContactAccountant = "accountant"
+	ProviderContactWarehouse  = "warehouse"
+	ProviderContactOther      = "other"
+)
+
+// ProviderAssignmentFilter selects provider assignments. Empty fields don't
+// filter, ActiveOn is a YYYY-MM-DD date.
+type ProviderAssignmentFilter struct {
+	ProviderId string
+	MagazinId  string
+	FilialId   string
+	ActiveOn   string
+	Offset     int64
+	Limit      int64
+}
+
+// ProviderCanBeAssigned reports whether a provider in status can be
+// assigned to deliver to magazins.
+func ProviderCanBeAssigned(status organization_service.ProviderStatus) bool {
+	return status != organization_service.ProviderStatus_PROVIDER_STATUS_BLACKLISTED &&
+		status != organization_service.ProviderStatus_PROVIDER_STATUS_ARCHIVED
+}
+
+// ProviderMerge merges the providers SourceIds into TargetId. MergedBy is the
+// id of the staff merging them, if known.
+type ProviderMerge struct {
+	TargetId  string
+	SourceIds []string
+	MergedBy  string
+}
@@ -0,0 +1,52 @@
+package models
+
+import "organization_service/genproto/organization_service"
+
+// purchaseOrderTransitions lists the statuses a purchase order can move to
+// from each status. Received and cancelled orders are final.
+var purchaseOrderTransitions = map[organization_service.PurchaseOrderStatus][]organization_service.PurchaseOrderStatus{
+	organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_DRAFT: {
+		organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_SUBMITTED,
+		organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_CANCELLED,
+	},
+	organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_SUBMITTED: {
+		organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_CONFIRMED,
+		organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_CANCELLED,
+	},
+	organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_CONFIRMED: {
+		organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_RECEIVED,
+		organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_CANCELLED,
+	},
+}
+
+// CanChangePurchaseOrderStatus reports whether a purchase order in status
+// from can be moved to status to.
+func CanChangePurchaseOrderStatus(from, to organization_service.PurchaseOrderStatus) bool {
+	for _, status := range purchaseOrderTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PurchaseOrderCreate is a new draft purchase order. The unit prices of the
+// items are already resolved. CreatedBy is the id of the staff creating the
+// order, if known.
+type PurchaseOrderCreate struct {
+	OrderNumber          string
+	MagazinId            string
+	ProviderId           string
+	ExpectedDeliveryDate string
+	Comment              string
+	CreatedBy            string
+	Items                []*organization_service.PurchaseOrderItemInput
+}
+
+// PurchaseOrderStatusChange moves a purchase order from status From to To.
+type PurchaseOrderStatusChange struct {
+	Id   string
+	From organization_service.PurchaseOrderStatus
+	To   organization_service.PurchaseOrderStatus
+}
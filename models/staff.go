@@ -0,0 +1,76 @@
+package models
+
+import "time"
+
+const (
+	StaffInvited    = "invited"
+	StaffActive     = "active"
+	StaffSuspended  = "suspended"
+	StaffTerminated = "terminated"
+)
+
+// staffTransitions lists the statuses a staff can move to from each status.
+// Terminated is final, the row is kept for the history of the staff.
+var staffTransitions = map[string][]string{
+	StaffInvited:   {StaffActive, StaffSuspended, StaffTerminated},
+	StaffActive:    {StaffSuspended, StaffTerminated},
+	StaffSuspended: {StaffActive, StaffTerminated},
+}
+
+// CanChangeStaffStatus reports whether a staff in status from can be moved
+// to status to.
+func CanChangeStaffStatus(from, to string) bool {
+	for _, status := range staffTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StaffCanLogin reports whether a staff in the effective status can log in.
+// Invited staff become active on their first login.
+func StaffCanLogin(status string) bool {
+	return status == StaffInvited || status == StaffActive
+}
+
+// StaffStatusChange moves a staff from status From to To. The change takes
+// effect at EffectiveAt, until then the staff keeps From.
+type StaffStatusChange struct {
+	StaffId     string
+	From        string
+	To          string
+	Reason      string
+	EffectiveAt time.Time
+}
+
+// StaffAuth is what login and token validation need to know about a staff.
+type StaffAuth struct {
+	Id                string
+	MagazinId         string
+	StaffType         string
+	Phone             string
+	Password          string
+	Status            string
+	PreviousStatus    string
+	StatusEffectiveAt time.Time
+	FailedLogins      int
+	Lockouts          int
+	LockedUntil       time.Time
+}
+
+// EffectiveStatus returns the status of the staff at t: a status change
+// dated in the future hasn't taken effect yet.
+func (s *StaffAuth) EffectiveStatus(t time.Time) string {
+	if s.PreviousStatus != "" && t.Before(s.StatusEffectiveAt) {
+		return s.PreviousStatus
+	}
+
+	return s.Status
+}
+
+// Locked reports whether the staff is locked out after failed logins at t.
+func (s *StaffAuth) Locked(t time.Time) bool {
+	return t.Before(s.LockedUntil)
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead"
+)
+
+// WebhookDelivery is a queued delivery claimed by the dispatcher together
+// with the target endpoint it has to be sent to.
+type WebhookDelivery struct {
+	Id        string
+	WebhookId string
+	EventType string
+	Url       string
+	Secret    string
+	Payload   []byte
+	Attempts  int
+}
+
+// WebhookDeliveryAttempt is the outcome of a single delivery attempt.
+type WebhookDeliveryAttempt struct {
+	Id            string
+	Status        string
+	Attempts      int
+	ResponseCode  int
+	LastError     string
+	NextAttemptAt time.Time
+}
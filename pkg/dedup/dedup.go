@@ -0,0 +1,278 @@
+// Package dedup finds records that describe the same company: the same phone,
+// the same INN or names that differ only in spelling, word order or legal
+// form, as in "Ali Trade MCHJ" and "ООО «Ali-Trade»".
+package dedup
+
+import (
+	"organization_service/pkg/phone"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Reasons two records are considered duplicates.
+const (
+	ReasonPhone = "phone"
+	ReasonINN   = "inn"
+	ReasonName  = "name"
+)
+
+// namePrefix is how many leading letters of normalized names must be equal
+// for Find to compare them, so that it doesn't compare every pair of records.
+const namePrefix = 3
+
+// legalForms are the words of company names that only name the legal form,
+// in Uzbek, Russian and English.
+var legalForms = map[string]bool{
+	"mchj": true, "xk": true, "xf": true, "yatt": true, "aj": true, "qk": true, "chp": true,
+	"мчж": true, "хк": true, "ятт": true,
+	"ооо": true, "оао": true, "зао": true, "пао": true, "ао": true, "ип": true, "чп": true,
+	"ooo": true, "llc": true, "ltd": true, "inc": true, "jsc": true, "ip": true,
+}
+
+// Record is what is compared of a record. Empty fields never match.
+type Record struct {
+	ID    string
+	Phone string
+	INN   string
+	Name  string
+}
+
+// Cluster is a group of records found to be duplicates of each other, with
+// every reason that linked two of them.
+type Cluster struct {
+	IDs     []string
+	Reasons []string
+}
+
+// NormalizeName lowercases name, drops punctuation and legal forms and sorts
+// the remaining words.
+func NormalizeName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	kept := words[:0]
+	for _, word := range words {
+		if !legalForms[word] {
+			kept = append(kept, word)
+		}
+	}
+	sort.Strings(kept)
+
+	return strings.Join(kept, " ")
+}
+
+// NormalizePhone normalizes number with phone.Normalize and falls back to
+// its digits when it isn't a valid phone number.
+func NormalizePhone(number string) string {
+	if normalized, err := phone.Normalize(number, ""); err == nil {
+		return normalized
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+}
+
+// Similarity is 1 minus the edit distance of a and b relative to the longer
+// of them, 1 for equal strings and 0 for entirely different ones.
+func Similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// Find clusters records sharing a normalized phone or an INN, or having
+// normalized names at least nameThreshold similar. Only names starting with
+// the same namePrefix letters are compared. Records in no cluster are left
+// out, clusters keep the order of records.
+func Find(records []Record, nameThreshold float64) []Cluster {
+	sets := newUnionFind(len(records))
+	reasons := make(map[int]map[string]bool)
+
+	link := func(a, b int, reason string) {
+		root := sets.union(a, b)
+		if reasons[root] == nil {
+			reasons[root] = make(map[string]bool)
+		}
+		reasons[root][reason] = true
+	}
+
+	linkEqual(records, func(r Record) string { return NormalizePhone(r.Phone) }, func(a, b int) { link(a, b, ReasonPhone) })
+	linkEqual(records, func(r Record) string { return strings.TrimSpace(r.INN) }, func(a, b int) { link(a, b, ReasonINN) })
+
+	names := make([][]rune, len(records))
+	blocks := make(map[string][]int)
+	for n, record := range records {
+		names[n] = []rune(NormalizeName(record.Name))
+		if len(names[n]) == 0 {
+			continue
+		}
+
+		prefix := names[n]
+		if len(prefix) > namePrefix {
+			prefix = prefix[:namePrefix]
+		}
+		blocks[string(prefix)] = append(blocks[string(prefix)], n)
+	}
+	for _, block := range blocks {
+		for i, a := range block {
+			for _, b := range block[i+1:] {
+				if similarNames(names[a], names[b], nameThreshold) {
+					link(a, b, ReasonName)
+				}
+			}
+		}
+	}
+
+	// the reasons of merged sets end up under different roots, gather them
+	// under the final ones
+	members := make(map[int][]int)
+	found := make(map[int]map[string]bool)
+	var roots []int
+	for n := range records {
+		root := sets.find(n)
+		if members[root] == nil {
+			roots = append(roots, root)
+			found[root] = make(map[string]bool)
+		}
+		members[root] = append(members[root], n)
+	}
+	for root, set := range reasons {
+		for reason := range set {
+			found[sets.find(root)][reason] = true
+		}
+	}
+
+	var clusters []Cluster
+	for _, root := range roots {
+		if len(members[root]) < 2 {
+			continue
+		}
+
+		cluster := Cluster{}
+		for _, n := range members[root] {
+			cluster.IDs = append(cluster.IDs, records[n].ID)
+		}
+		for _, reason := range []string{ReasonPhone, ReasonINN, ReasonName} {
+			if found[root][reason] {
+				cluster.Reasons = append(cluster.Reasons, reason)
+			}
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters
+}
+
+// linkEqual links the records with the same non empty key.
+func linkEqual(records []Record, key func(Record) string, link func(a, b int)) {
+	first := make(map[string]int)
+	for n, record := range records {
+		value := key(record)
+		if value == "" {
+			continue
+		}
+
+		if m, ok := first[value]; ok {
+			link(m, n)
+		} else {
+			first[value] = n
+		}
+	}
+}
+
+func similarNames(a, b []rune, threshold float64) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+
+	// the distance is at least the difference of the lengths, skip pairs
+	// that can't reach the threshold without computing it
+	longest, diff := len(a), len(a)-len(b)
+	if len(b) > longest {
+		longest = len(b)
+	}
+	if diff < 0 {
+		diff = -diff
+	}
+	if 1-float64(diff)/float64(longest) < threshold {
+		return false
+	}
+
+	return 1-float64(levenshtein(a, b))/float64(longest) >= threshold
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+type unionFind struct {
+	parent []int
+}
+
+func newUnionFind(n int) *unionFind {
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+	}
+	return &unionFind{parent: parent}
+}
+
+func (u *unionFind) find(n int) int {
+	for u.parent[n] != n {
+		u.parent[n] = u.parent[u.parent[n]]
+		n = u.parent[n]
+	}
+	return n
+}
+
+// union joins the sets of a and b and returns the root of the joined set.
+func (u *unionFind) union(a, b int) int {
+	ra, rb := u.find(a), u.find(b)
+	if ra != rb {
+		u.parent[rb] = ra
+	}
+	return ra
+}
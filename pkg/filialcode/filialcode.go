@@ -0,0 +1,128 @@
+// Package filialcode builds filial codes from a configurable pattern such as
+// "{prefix}-{region}-{seq}", e.g. FL-TAS-0001. Sequences are counted per code
+// space, that is per distinct expansion of the pattern without {seq}.
+package filialcode
+
+import (
+	"fmt"
+	"organization_service/config"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	PlaceholderPrefix   = "{prefix}"
+	PlaceholderRegion   = "{region}"
+	PlaceholderSequence = "{seq}"
+
+	// MaxLength is the size of the filial_code column.
+	MaxLength = 50
+	// MaxRegionLength is the size of the region column.
+	MaxRegionLength = 20
+)
+
+var codeRegexp = regexp.MustCompile(`^[A-Z0-9][A-Z0-9-]*$`)
+
+type Generator struct {
+	Pattern       string
+	Prefix        string
+	DefaultRegion string
+	Width         int
+}
+
+// NewGenerator returns the generator configured by cfg. It returns an error
+// if the pattern can't tell the codes of a code space apart, that is if it
+// doesn't hold {seq} exactly once, or doesn't make valid codes.
+func NewGenerator(cfg config.Config) (Generator, error) {
+	g := Generator{
+		Pattern:       cfg.FilialCodePattern,
+		Prefix:        cfg.FilialCodePrefix,
+		DefaultRegion: cfg.FilialCodeDefaultRegion,
+		Width:         cfg.FilialCodeSequenceWidth,
+	}
+
+	if strings.Count(g.Pattern, PlaceholderSequence) != 1 {
+		return Generator{}, fmt.Errorf("filial code pattern %q must contain %s exactly once", g.Pattern, PlaceholderSequence)
+	}
+
+	if g.Width < 1 {
+		return Generator{}, fmt.Errorf("filial code sequence width %d must be at least 1", g.Width)
+	}
+
+	if _, err := g.Format("", 1); err != nil {
+		return Generator{}, err
+	}
+
+	return g, nil
+}
+
+// Region returns the normalized region used in codes: upper case letters and
+// digits only, DefaultRegion if nothing is left.
+func (g Generator) Region(region string) string {
+	region = NormalizeRegion(region)
+	if region == "" {
+		return NormalizeRegion(g.DefaultRegion)
+	}
+
+	return region
+}
+
+// Key returns the counter key of the code space of region.
+func (g Generator) Key(region string) string {
+	return strings.NewReplacer(
+		PlaceholderPrefix, g.Prefix,
+		PlaceholderRegion, g.Region(region),
+	).Replace(g.Pattern)
+}
+
+// Format returns the code with sequence number seq in the code space of
+// region.
+func (g Generator) Format(region string, seq int64) (string, error) {
+	code := strings.NewReplacer(
+		PlaceholderPrefix, g.Prefix,
+		PlaceholderRegion, g.Region(region),
+		PlaceholderSequence, fmt.Sprintf("%0*d", g.Width, seq),
+	).Replace(g.Pattern)
+
+	if err := Validate(code); err != nil {
+		return "", fmt.Errorf("filial code pattern %q: %w", g.Pattern, err)
+	}
+
+	return code, nil
+}
+
+// NormalizeRegion upper cases region and drops everything but letters and
+// digits.
+func NormalizeRegion(region string) string {
+	region = strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, region)
+
+	if len(region) > MaxRegionLength {
+		region = region[:MaxRegionLength]
+	}
+
+	return region
+}
+
+// Normalize upper cases an explicitly given code and trims spaces around it.
+func Normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate reports whether code can be stored as a filial code.
+func Validate(code string) error {
+	if len(code) > MaxLength {
+		return fmt.Errorf("filial code %q is longer than %d characters", code, MaxLength)
+	}
+
+	if !codeRegexp.MatchString(code) {
+		return fmt.Errorf("filial code %q may only contain latin letters, digits and dashes", code)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Package geo measures distances between points on the Earth given in
+// degrees of latitude and longitude.
+package geo
+
+import "math"
+
+// EarthRadius is the mean radius of the Earth in meters.
+const EarthRadius = 6371008.8
+
+// Distance returns the great-circle distance in meters between two points,
+// by the haversine formula.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dPhi := radians(lat2 - lat1)
+	dLambda := radians(lon2 - lon1)
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) +
+		math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Box is a range of latitudes and longitudes in degrees.
+type Box struct {
+	MinLat, MaxLat float64
+	MinLon, MaxLon float64
+}
+
+// BoundingBox returns a box holding every point within radius meters of the
+// point. Near the poles and the antimeridian it spans all longitudes.
+func BoundingBox(lat, lon, radius float64) Box {
+	dLat := degrees(radius / EarthRadius)
+
+	box := Box{
+		MinLat: math.Max(lat-dLat, -90),
+		MaxLat: math.Min(lat+dLat, 90),
+		MinLon: -180,
+		MaxLon: 180,
+	}
+
+	if box.MinLat == -90 || box.MaxLat == 90 {
+		return box
+	}
+
+	// the meridians tangent to the circle of radius around the point
+	dLon := degrees(math.Asin(math.Min(1, math.Sin(radius/EarthRadius)/math.Cos(radians(lat)))))
+	if lon-dLon >= -180 && lon+dLon <= 180 {
+		box.MinLon, box.MaxLon = lon-dLon, lon+dLon
+	}
+
+	return box
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func degrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
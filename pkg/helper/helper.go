@@ -127,19 +127,3 @@ func NewNullBool(s bool) sql.NullBool {
 		Valid: true,
 	}
 }
-
-
-func CombineFirstLetters(str string) string {
-
-	words := strings.Split(str, " ")
-
-	if len(words) < 2 {
-		return ""
-	}
-
-	firstInitial := string(words[0][0])
-	secondInitial := string(words[1][0])
-	initials := firstInitial + secondInitial
-
-	return strings.ToUpper(initials)
-}
\ No newline at end of file
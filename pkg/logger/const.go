@@ -16,3 +16,10 @@ const (
 	// LevelFatal ...
 	LevelFatal = "fatal"
 )
+
+const (
+	// FormatConsole ...
+	FormatConsole = "console"
+	// FormatJSON ...
+	FormatJSON = "json"
+)
@@ -0,0 +1,34 @@
+package logger
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type contextKey struct{}
+
+var defaultLogger atomic.Value
+
+// SetDefault sets the logger FromContext falls back to when ctx carries none.
+func SetDefault(l LoggerI) {
+	defaultLogger.Store(&l)
+}
+
+// NewContext returns a copy of ctx carrying l.
+func NewContext(ctx context.Context, l LoggerI) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the request scoped logger stored in ctx by NewContext,
+// or the default logger if there is none.
+func FromContext(ctx context.Context) LoggerI {
+	if l, ok := ctx.Value(contextKey{}).(LoggerI); ok {
+		return l
+	}
+
+	if l, ok := defaultLogger.Load().(*LoggerI); ok {
+		return *l
+	}
+
+	return nopLogger
+}
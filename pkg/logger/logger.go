@@ -1,6 +1,9 @@
 package logger
 
 import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -30,20 +33,23 @@ type LoggerI interface {
 	DPanic(msg string, fields ...Field)
 	Panic(msg string, fields ...Field)
 	Fatal(msg string, fields ...Field)
+	With(fields ...Field) LoggerI
 }
 
 type loggerImpl struct {
 	zap *zap.Logger
 }
 
+var nopLogger LoggerI = &loggerImpl{zap: zap.NewNop()}
+
 // NewLogger ...
-func NewLogger(namespace string, level string) LoggerI {
+func NewLogger(namespace, level, format string) LoggerI {
 	if level == "" {
 		level = LevelInfo
 	}
 
 	logger := loggerImpl{
-		zap: newZapLogger(namespace, level),
+		zap: newZapLogger(namespace, level, format),
 	}
 
 	return &logger
@@ -77,6 +83,13 @@ func (l *loggerImpl) Fatal(msg string, fields ...Field) {
 	l.zap.Fatal(msg, fields...)
 }
 
+// With returns a child logger that adds fields to every entry.
+func (l *loggerImpl) With(fields ...Field) LoggerI {
+	return &loggerImpl{
+		zap: l.zap.With(fields...),
+	}
+}
+
 // GetNamed ...
 func GetNamed(l LoggerI, name string) LoggerI {
 	switch v := l.(type) {
@@ -91,15 +104,21 @@ func GetNamed(l LoggerI, name string) LoggerI {
 
 // WithFields ...
 func WithFields(l LoggerI, fields ...Field) LoggerI {
-	switch v := l.(type) {
-	case *loggerImpl:
-		return &loggerImpl{
-			zap: v.zap.With(fields...),
-		}
-	default:
-		l.Info("logger.WithFields: invalid logger type")
+	return l.With(fields...)
+}
+
+// WithTrace returns l with the trace and span ids of the span in ctx.
+// l is returned as is when ctx carries no span.
+func WithTrace(ctx context.Context, l LoggerI) LoggerI {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
 		return l
 	}
+
+	return WithFields(l,
+		String("trace_id", sc.TraceID().String()),
+		String("span_id", sc.SpanID().String()),
+	)
 }
 
 // Cleanup ...
@@ -0,0 +1,156 @@
+package logger
+
+import (
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	sensitiveMu sync.RWMutex
+	// sensitiveFields lists field names that are never written to logs,
+	// neither as log fields nor as fields of logged proto messages.
+	sensitiveFields = map[string]bool{
+		"password":     true,
+		"new_password": true,
+		"secret":       true,
+		"token":        true,
+		"access_token": true,
+		"otp":          true,
+	}
+)
+
+// RedactFields adds names to the denylist of masked fields.
+func RedactFields(names ...string) {
+	sensitiveMu.Lock()
+	defer sensitiveMu.Unlock()
+
+	for _, name := range names {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			sensitiveFields[name] = true
+		}
+	}
+}
+
+func isSensitive(name string) bool {
+	sensitiveMu.RLock()
+	defer sensitiveMu.RUnlock()
+
+	return sensitiveFields[strings.ToLower(name)]
+}
+
+// Redact returns a copy of msg with every sensitive field masked, at any
+// depth. msg itself is left untouched.
+func Redact(msg proto.Message) proto.Message {
+	if msg == nil {
+		return nil
+	}
+
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect())
+
+	return clone
+}
+
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case isSensitive(string(fd.Name())):
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(redacted))
+			} else {
+				m.Clear(fd)
+			}
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.IsMap():
+			redactMap(v.Map(), fd.MapValue())
+		case fd.Message() != nil && !fd.IsMap():
+			redactMessage(v.Message())
+		}
+
+		return true
+	})
+}
+
+// redactMap masks entries keyed by a sensitive name, which covers
+// google.protobuf.Struct payloads such as the fields of UpdatePatch requests.
+func redactMap(m protoreflect.Map, vd protoreflect.FieldDescriptor) {
+	m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		if !isSensitive(k.String()) {
+			if vd.Message() != nil {
+				redactMessage(v.Message())
+			}
+			return true
+		}
+
+		switch {
+		case vd.Kind() == protoreflect.StringKind:
+			m.Set(k, protoreflect.ValueOfString(redacted))
+		case vd.Message() != nil && vd.Message().FullName() == "google.protobuf.Value":
+			m.Set(k, protoreflect.ValueOfMessage(structpb.NewStringValue(redacted).ProtoReflect()))
+		default:
+			m.Clear(k)
+		}
+
+		return true
+	})
+}
+
+// redactField masks a field named in the denylist and redacts proto
+// messages passed through Any.
+func redactField(f Field) Field {
+	if isSensitive(f.Key) {
+		return String(f.Key, redacted)
+	}
+
+	if msg, ok := f.Interface.(proto.Message); ok {
+		f.Interface = Redact(msg)
+	}
+
+	return f
+}
+
+func redactFields(fields []Field) []Field {
+	redactedFields := make([]Field, len(fields))
+	for i := range fields {
+		redactedFields[i] = redactField(fields[i])
+	}
+
+	return redactedFields
+}
+
+// redactingCore applies redactField to everything written through it,
+// so callers can log requests as is.
+type redactingCore struct {
+	zapcore.Core
+}
+
+func newRedactingCore(core zapcore.Core) zapcore.Core {
+	return &redactingCore{Core: core}
+}
+
+func (c *redactingCore) With(fields []Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(redactFields(fields))}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []Field) error {
+	return c.Core.Write(entry, redactFields(fields))
+}
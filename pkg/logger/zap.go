@@ -9,7 +9,7 @@ import (
 	"golang.org/x/crypto/ssh/terminal"
 )
 
-func newZapLogger(namespace, level string) *zap.Logger {
+func newZapLogger(namespace, level, format string) *zap.Logger {
 	globalLevel := parseLevel(level)
 
 	highPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
@@ -23,11 +23,22 @@ func newZapLogger(namespace, level string) *zap.Logger {
 	logStdErrorWriter := zapcore.Lock(os.Stderr)
 	logStdInfoWriter := zapcore.Lock(os.Stdout)
 
-	isTTY := terminal.IsTerminal(int(os.Stderr.Fd()))
+	newEncoder := func() zapcore.Encoder {
+		if format == FormatJSON {
+			encoderConfig := zap.NewProductionEncoderConfig()
+			encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+			return zapcore.NewJSONEncoder(encoderConfig)
+		}
 
+		isTTY := terminal.IsTerminal(int(os.Stderr.Fd()))
+		return logging.NewEncoder(4, isTTY)
+	}
+
+	// Each core is wrapped on its own: a tee writes to all of its cores
+	// once any of them accepts the entry.
 	core := zapcore.NewTee(
-		zapcore.NewCore(logging.NewEncoder(4, isTTY), logStdErrorWriter, highPriority),
-		zapcore.NewCore(logging.NewEncoder(4, isTTY), logStdInfoWriter, lowPriority),
+		newRedactingCore(zapcore.NewCore(newEncoder(), logStdErrorWriter, highPriority)),
+		newRedactingCore(zapcore.NewCore(newEncoder(), logStdInfoWriter, lowPriority)),
 	)
 
 	logger := zap.New(
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "organization_service"
+
+var (
+	// RPCHandled counts finished gRPC calls by method and status code.
+	RPCHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_handled_total",
+		Help:      "Total number of gRPC calls completed on the server, regardless of success or failure.",
+	}, []string{"method", "code"})
+
+	// RPCDuration observes the handling time of gRPC calls by method.
+	RPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_server_handling_seconds",
+		Help:      "Time spent handling gRPC calls.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// QueryDuration observes the duration of repository methods.
+	QueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Time spent in repository methods, by repository and method.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"repo", "method"})
+)
+
+func init() {
+	prometheus.MustRegister(RPCHandled, RPCDuration, QueryDuration)
+}
+
+// Register registers collectors with the default registry. Collectors that
+// are already registered are skipped.
+func Register(collectors ...prometheus.Collector) error {
+	for _, c := range collectors {
+		err := prometheus.Register(c)
+
+		var are prometheus.AlreadyRegisteredError
+		if err != nil && !errors.As(err, &are) {
+			return err
+		}
+	}
+
+	return nil
+}
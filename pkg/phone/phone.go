@@ -0,0 +1,83 @@
+// Package phone parses phone numbers written in the usual local and
+// international forms and normalizes them to E.164, e.g. "90 123 45 67",
+// "998901234567" and "+998 (90) 123-45-67" all become "+998901234567".
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultCountryCode is the calling code of Uzbekistan.
+const DefaultCountryCode = "998"
+
+const (
+	// maxDigits is the maximum length of an E.164 number without the plus.
+	maxDigits = 15
+	minDigits = 8
+
+	// uzNationalDigits is the length of Uzbek numbers without country code.
+	uzNationalDigits = 9
+)
+
+var ErrInvalid = errors.New("invalid phone number")
+
+// Normalize returns raw in E.164 form. Numbers without a country code are
+// assumed to belong to countryCode, DefaultCountryCode if it is empty.
+func Normalize(raw, countryCode string) (string, error) {
+	if countryCode == "" {
+		countryCode = DefaultCountryCode
+	}
+
+	value := strings.TrimSpace(raw)
+	international := strings.HasPrefix(value, "+")
+
+	var digits strings.Builder
+	for i, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+		default:
+			return "", fmt.Errorf("%w %q: unexpected character %q", ErrInvalid, raw, r)
+		}
+	}
+
+	number := digits.String()
+	if !international && strings.HasPrefix(number, "00") {
+		international = true
+		number = number[2:]
+	}
+
+	if !international && !hasCountryCode(number, countryCode) {
+		number = countryCode + number
+	}
+
+	if len(number) < minDigits || len(number) > maxDigits || number[0] == '0' {
+		return "", fmt.Errorf("%w %q", ErrInvalid, raw)
+	}
+
+	if strings.HasPrefix(number, DefaultCountryCode) && len(number) != len(DefaultCountryCode)+uzNationalDigits {
+		return "", fmt.Errorf("%w %q: Uzbek numbers have %d digits after +%s", ErrInvalid, raw, uzNationalDigits, DefaultCountryCode)
+	}
+
+	return "+" + number, nil
+}
+
+// Valid reports whether raw can be normalized.
+func Valid(raw, countryCode string) bool {
+	_, err := Normalize(raw, countryCode)
+	return err == nil
+}
+
+// hasCountryCode reports whether a number written without plus already
+// starts with countryCode, as in "998901234567".
+func hasCountryCode(number, countryCode string) bool {
+	if countryCode == DefaultCountryCode {
+		return len(number) == len(DefaultCountryCode)+uzNationalDigits && strings.HasPrefix(number, DefaultCountryCode)
+	}
+
+	return strings.HasPrefix(number, countryCode)
+}
@@ -0,0 +1,73 @@
+package phone
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		raw         string
+		countryCode string
+		want        string
+	}{
+		{"+998901234567", "", "+998901234567"},
+		{"998901234567", "", "+998901234567"},
+		{"901234567", "", "+998901234567"},
+		{"90 123 45 67", "", "+998901234567"},
+		{"+998 (90) 123-45-67", "", "+998901234567"},
+		{"90.123.45.67", "", "+998901234567"},
+		{" +998901234567 ", "", "+998901234567"},
+		{"00998901234567", "", "+998901234567"},
+		{"+79161234567", "", "+79161234567"},
+		{"0079161234567", "", "+79161234567"},
+		{"9161234567", "7", "+79161234567"},
+		{"79161234567", "7", "+79161234567"},
+		{"901234567", DefaultCountryCode, "+998901234567"},
+	}
+
+	for _, tt := range tests {
+		got, err := Normalize(tt.raw, tt.countryCode)
+		if err != nil {
+			t.Errorf("Normalize(%q, %q) = %v, want %q", tt.raw, tt.countryCode, err, tt.want)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Normalize(%q, %q) = %q, want %q", tt.raw, tt.countryCode, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeInvalid(t *testing.T) {
+	tests := []struct {
+		raw         string
+		countryCode string
+	}{
+		{"", ""},
+		{"12345", ""},
+		{"90123456", ""},
+		{"9012345678", ""},
+		{"+99890123456", ""},
+		{"+9989012345678", ""},
+		{"90 123 45 6x", ""},
+		{"90+1234567", ""},
+		{"+0901234567", ""},
+		{"+1234567890123456", ""},
+	}
+
+	for _, tt := range tests {
+		got, err := Normalize(tt.raw, tt.countryCode)
+		if !errors.Is(err, ErrInvalid) {
+			t.Errorf("Normalize(%q, %q) = %q, %v, want ErrInvalid", tt.raw, tt.countryCode, got, err)
+		}
+	}
+}
+
+func TestValid(t *testing.T) {
+	if !Valid("90 123 45 67", "") {
+		t.Errorf("Valid(%q) = false, want true", "90 123 45 67")
+	}
+	if Valid("90 123", "") {
+		t.Errorf("Valid(%q) = true, want false", "90 123")
+	}
+}
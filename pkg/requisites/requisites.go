@@ -0,0 +1,115 @@
+// Package requisites checks the identifiers Uzbek companies are registered
+// and paid with: the INN (STIR, the tax id), the MFO (the bank code) and the
+// bank account number.
+package requisites
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	innDigits     = 9
+	mfoDigits     = 5
+	accountDigits = 20
+
+	// accountKeyIndex is the position of the control key in an account
+	// number, after the 5 digit balance account and the 3 digit currency.
+	accountKeyIndex = 8
+)
+
+var (
+	ErrInvalidINN     = errors.New("invalid INN")
+	ErrInvalidMFO     = errors.New("invalid MFO")
+	ErrInvalidAccount = errors.New("invalid account number")
+)
+
+// accountWeights are the weights the Central Bank assigns to the digits of
+// the MFO followed by the account number, repeated from the first digit on.
+var accountWeights = [...]int{7, 1, 3}
+
+// Normalize drops the spaces and dashes identifiers are often written with,
+// as in "20 123 456 7" or "2020 8000 ...".
+func Normalize(raw string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(raw))
+}
+
+// ValidateINN checks that inn is 9 digits not starting with 0. The tax
+// committee publishes no check digit for the INN, whether one exists can only
+// be told by its registry, so its form is all that is checked here.
+func ValidateINN(inn string) error {
+	if !digits(inn, innDigits) || inn[0] == '0' {
+		return fmt.Errorf("%w %q: must be %d digits not starting with 0", ErrInvalidINN, inn, innDigits)
+	}
+
+	return nil
+}
+
+// ValidateMFO checks that mfo is 5 digits.
+func ValidateMFO(mfo string) error {
+	if !digits(mfo, mfoDigits) {
+		return fmt.Errorf("%w %q: must be %d digits", ErrInvalidMFO, mfo, mfoDigits)
+	}
+
+	return nil
+}
+
+// ValidateAccount checks that account is 20 digits and that its control key
+// matches the bank with the given MFO.
+func ValidateAccount(mfo, account string) error {
+	if err := ValidateMFO(mfo); err != nil {
+		return err
+	}
+
+	if !digits(account, accountDigits) {
+		return fmt.Errorf("%w %q: must be %d digits", ErrInvalidAccount, account, accountDigits)
+	}
+
+	if key := AccountKey(mfo, account); account[accountKeyIndex] != key {
+		return fmt.Errorf("%w %q: control key doesn't match MFO %s", ErrInvalidAccount, account, mfo)
+	}
+
+	return nil
+}
+
+// AccountKey returns the control key of account at the bank with the given
+// MFO: the digit that makes the sum of the digits of the MFO and the account,
+// weighted 7, 1, 3, 7, ..., a multiple of 10. The current key of account is
+// ignored. Both arguments must have the right number of digits.
+func AccountKey(mfo, account string) byte {
+	number := mfo + account[:accountKeyIndex] + "0" + account[accountKeyIndex+1:]
+
+	sum := 0
+	for i := 0; i < len(number); i++ {
+		sum += int(number[i]-'0') * accountWeights[i%len(accountWeights)]
+	}
+
+	weight := accountWeights[(len(mfo)+accountKeyIndex)%len(accountWeights)]
+	for key := 0; key < 10; key++ {
+		if (sum+key*weight)%10 == 0 {
+			return byte('0' + key)
+		}
+	}
+
+	return '0'
+}
+
+func digits(value string, n int) bool {
+	if len(value) != n {
+		return false
+	}
+
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
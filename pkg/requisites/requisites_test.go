@@ -0,0 +1,118 @@
+package requisites
+
+import (
+	"errors"
+	"testing"
+)
+
+// The single treasury account of the State budget, published with the
+// payment details of every budget institution.
+const (
+	treasuryINN     = "201122919"
+	treasuryMFO     = "00014"
+	treasuryAccount = "23402000300100001010"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"201122919", "201122919"},
+		{" 201 122 919 ", "201122919"},
+		{"2340-2000-3001-0000-1010", "23402000300100001010"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Normalize(tt.raw); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestValidateINN(t *testing.T) {
+	tests := []struct {
+		inn   string
+		valid bool
+	}{
+		{treasuryINN, true},
+		{"301234567", true},
+		{"012345678", false},
+		{"20112291", false},
+		{"2011229190", false},
+		{"20112291a", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateINN(tt.inn)
+		if tt.valid && err != nil {
+			t.Errorf("ValidateINN(%q) = %v, want nil", tt.inn, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidINN) {
+			t.Errorf("ValidateINN(%q) = %v, want ErrInvalidINN", tt.inn, err)
+		}
+	}
+}
+
+func TestValidateMFO(t *testing.T) {
+	tests := []struct {
+		mfo   string
+		valid bool
+	}{
+		{treasuryMFO, true},
+		{"0001", false},
+		{"000140", false},
+		{"0001a", false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateMFO(tt.mfo)
+		if tt.valid && err != nil {
+			t.Errorf("ValidateMFO(%q) = %v, want nil", tt.mfo, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrInvalidMFO) {
+			t.Errorf("ValidateMFO(%q) = %v, want ErrInvalidMFO", tt.mfo, err)
+		}
+	}
+}
+
+func TestValidateAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		mfo     string
+		account string
+		err     error
+	}{
+		{"treasury account", treasuryMFO, treasuryAccount, nil},
+		{"wrong key", treasuryMFO, "23402000400100001010", ErrInvalidAccount},
+		{"changed digit", treasuryMFO, "23402000300100001011", ErrInvalidAccount},
+		{"swapped digits", treasuryMFO, "32402000300100001010", ErrInvalidAccount},
+		{"other bank", "00873", treasuryAccount, ErrInvalidAccount},
+		{"short", treasuryMFO, "2340200030010000101", ErrInvalidAccount},
+		{"letters", treasuryMFO, "2340200030010000101a", ErrInvalidAccount},
+		{"invalid mfo", "0001", treasuryAccount, ErrInvalidMFO},
+	}
+
+	for _, tt := range tests {
+		err := ValidateAccount(tt.mfo, tt.account)
+		if tt.err == nil && err != nil {
+			t.Errorf("%s: ValidateAccount(%q, %q) = %v, want nil", tt.name, tt.mfo, tt.account, err)
+		}
+		if tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("%s: ValidateAccount(%q, %q) = %v, want %v", tt.name, tt.mfo, tt.account, err, tt.err)
+		}
+	}
+}
+
+func TestAccountKey(t *testing.T) {
+	if key := AccountKey(treasuryMFO, treasuryAccount); key != '3' {
+		t.Fatalf("AccountKey(%q, %q) = %q, want '3'", treasuryMFO, treasuryAccount, key)
+	}
+
+	// the key doesn't depend on the key already in the account
+	if key := AccountKey(treasuryMFO, "23402000900100001010"); key != '3' {
+		t.Fatalf("AccountKey ignoring the current key = %q, want '3'", key)
+	}
+}
@@ -0,0 +1,185 @@
+// Package schedule computes when a store is open from its weekly hours and
+// the exceptions of particular dates, in the time zone of the store.
+//
+// Hours are wall clock times of a single day: a store open past midnight
+// closes at 24:00 and opens again at 00:00 the next day.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DateLayout is the layout of dates.
+const DateLayout = "2006-01-02"
+
+// dayMinutes is the number of minutes of a day, 24:00 in clock time.
+const dayMinutes = 24 * 60
+
+// Interval is a span of a day in minutes since midnight, from Opens up to
+// but not including Closes.
+type Interval struct {
+	Opens  int
+	Closes int
+}
+
+// Contains reports whether the minute of the day is in the interval.
+func (i Interval) Contains(minute int) bool {
+	return minute >= i.Opens && minute < i.Closes
+}
+
+// Week holds the hours of each ISO weekday, Week[1] is Monday and Week[7]
+// Sunday. A day without hours is closed.
+type Week [8][]Interval
+
+// Exception replaces the weekly hours of a date. Closed exceptions, as
+// holidays, have no hours.
+type Exception struct {
+	Closed bool
+	Hours  []Interval
+	Reason string
+}
+
+// Schedule is the weekly hours and the exceptions, by YYYY-MM-DD date, of a
+// store in Location.
+type Schedule struct {
+	Location   *time.Location
+	Week       Week
+	Exceptions map[string]Exception
+}
+
+// Day is the effective hours of a date.
+type Day struct {
+	Date      string
+	Weekday   int
+	Hours     []Interval
+	Exception bool
+	Reason    string
+}
+
+// Closed reports whether the store doesn't open on the day.
+func (d Day) Closed() bool {
+	return len(d.Hours) == 0
+}
+
+// ISOWeekday returns the ISO weekday of t, 1 for Monday to 7 for Sunday.
+func ISOWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
+
+// ParseClock parses an HH:MM clock time into minutes since midnight. 24:00
+// is the end of the day.
+func ParseClock(clock string) (int, error) {
+	if len(clock) != 5 || clock[2] != ':' {
+		return 0, fmt.Errorf("%q is not an HH:MM time", clock)
+	}
+	for _, n := range []int{0, 1, 3, 4} {
+		if clock[n] < '0' || clock[n] > '9' {
+			return 0, fmt.Errorf("%q is not an HH:MM time", clock)
+		}
+	}
+
+	hours := int(clock[0]-'0')*10 + int(clock[1]-'0')
+	minutes := int(clock[3]-'0')*10 + int(clock[4]-'0')
+	if minutes > 59 || hours*60+minutes > dayMinutes {
+		return 0, fmt.Errorf("%q is not a time of the day", clock)
+	}
+
+	return hours*60 + minutes, nil
+}
+
+// FormatClock formats minutes since midnight as HH:MM.
+func FormatClock(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+// ParseInterval parses the HH:MM times an interval opens and closes at.
+func ParseInterval(opens, closes string) (Interval, error) {
+	o, err := ParseClock(opens)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	c, err := ParseClock(closes)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	if c <= o {
+		return Interval{}, errors.New("closing time must be after opening time")
+	}
+
+	return Interval{Opens: o, Closes: c}, nil
+}
+
+// Normalize sorts the intervals and returns an error if any of them overlap.
+func Normalize(hours []Interval) error {
+	sort.Slice(hours, func(a, b int) bool {
+		return hours[a].Opens < hours[b].Opens
+	})
+
+	for n := 1; n < len(hours); n++ {
+		if hours[n].Opens < hours[n-1].Closes {
+			return fmt.Errorf("%s-%s overlaps %s-%s",
+				FormatClock(hours[n-1].Opens), FormatClock(hours[n-1].Closes),
+				FormatClock(hours[n].Opens), FormatClock(hours[n].Closes))
+		}
+	}
+
+	return nil
+}
+
+// Day returns the effective hours of the date.
+func (s *Schedule) Day(date time.Time) Day {
+	day := Day{
+		Date:    date.Format(DateLayout),
+		Weekday: ISOWeekday(date),
+	}
+
+	if exception, ok := s.Exceptions[day.Date]; ok {
+		day.Exception = true
+		day.Reason = exception.Reason
+		if !exception.Closed {
+			day.Hours = exception.Hours
+		}
+		return day
+	}
+
+	day.Hours = s.Week[day.Weekday]
+
+	return day
+}
+
+// Days returns the effective hours of the dates from from to to, both
+// included.
+func (s *Schedule) Days(from, to time.Time) []Day {
+	var days []Day
+
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		days = append(days, s.Day(date))
+	}
+
+	return days
+}
+
+// IsOpen reports whether the store is open at the instant at, and returns
+// the day of at in the time zone of the store with the interval it is open
+// in.
+func (s *Schedule) IsOpen(at time.Time) (open bool, current Interval, day Day) {
+	local := at.In(s.Location)
+	day = s.Day(local)
+
+	minute := local.Hour()*60 + local.Minute()
+	for _, interval := range day.Hours {
+		if interval.Contains(minute) {
+			return true, interval, day
+		}
+	}
+
+	return false, Interval{}, day
+}
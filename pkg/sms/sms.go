@@ -0,0 +1,89 @@
+// Package sms sends text messages to phones. The sender is chosen by
+// config.SMSSender; only development senders are built in, a gateway can be
+// plugged in by implementing Sender.
+package sms
+
+import (
+	"context"
+	"fmt"
+	"organization_service/config"
+	"organization_service/pkg/logger"
+	"sync"
+)
+
+const (
+	SenderConsole = "console"
+	SenderFake    = "fake"
+)
+
+// Sender sends text to an E.164 phone number.
+type Sender interface {
+	Send(ctx context.Context, phone, text string) error
+}
+
+// NewSender returns the sender configured by cfg.SMSSender.
+func NewSender(cfg config.Config, log logger.LoggerI) (Sender, error) {
+	switch cfg.SMSSender {
+	case SenderConsole, "":
+		return NewConsoleSender(log), nil
+	case SenderFake:
+		return NewFakeSender(), nil
+	default:
+		return nil, fmt.Errorf("unknown sms sender %q", cfg.SMSSender)
+	}
+}
+
+// ConsoleSender writes messages to the log instead of sending them.
+type ConsoleSender struct {
+	log logger.LoggerI
+}
+
+func NewConsoleSender(log logger.LoggerI) *ConsoleSender {
+	return &ConsoleSender{log: log}
+}
+
+func (s *ConsoleSender) Send(ctx context.Context, phone, text string) error {
+	// The text is logged under a key the logger doesn't redact on purpose,
+	// this sender only exists for development.
+	s.log.Info("SMS: message sent", logger.String("phone", phone), logger.String("text", text))
+	return nil
+}
+
+// Message is an SMS recorded by FakeSender.
+type Message struct {
+	Phone string
+	Text  string
+}
+
+// FakeSender records messages so tests can read them back.
+type FakeSender struct {
+	mu       sync.Mutex
+	messages []Message
+	// Err is returned by Send when set.
+	Err error
+}
+
+func NewFakeSender() *FakeSender {
+	return &FakeSender{}
+}
+
+func (s *FakeSender) Send(ctx context.Context, phone, text string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	s.messages = append(s.messages, Message{Phone: phone, Text: text})
+
+	return nil
+}
+
+// Messages returns the messages sent so far.
+func (s *FakeSender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Message(nil), s.messages...)
+}
@@ -0,0 +1,542 @@
+package validation
+
+import (
+	"fmt"
+	"organization_service/genproto/organization_service"
+	"organization_service/models"
+	"organization_service/pkg/schedule"
+	"time"
+)
+
+// Column sizes of the schema.
+const (
+	maxNameLength        = 100
+	maxAddressLength     = 100
+	maxPersonNameLength  = 50
+	maxLoginLength       = 50
+	maxPasswordLength    = 72
+	maxStaffTypeLength   = 50
+	maxProviderNameLen   = 50
+	maxFilialCodeLength  = 50
+	maxRegionLength      = 20
+	maxPhoneLength       = 32
+	maxURLLength         = 500
+	maxSecretLength      = 100
+	maxSearchLength      = 100
+	maxEventTypeLength   = 50
+	maxDeliveryStatusLen = 20
+	maxReasonLength      = 255
+	maxTimestampLength   = 64
+	maxTokenLength       = 4096
+	maxOTPLength         = 12
+	maxLegalNameLength   = 255
+	maxLegalAddressLen   = 255
+	maxRequisiteLength   = 32
+	maxContactNameLength = 100
+	maxEmailLength       = 255
+	maxLeadTimeDays      = 365
+	maxSupplierSKULength = 64
+	maxMinOrderQty       = 1000000
+	// maxPurchasePrice fits the NUMERIC(14, 2) column
+	maxPurchasePrice = 1e12
+
+	maxCommentLength      = 255
+	maxPurchaseOrderItems = 200
+	maxOrderQuantity      = 1000000
+
+	maxRating          = 5
+	maxScorePeriodDays = 3650
+
+	maxMergeSources = 50
+
+	maxTimeZoneLength = 64
+	// maxWorkingHours allows a few intervals on each weekday
+	maxWorkingHours = 28
+	// maxScheduleDays bounds the dates of GetSchedule
+	maxScheduleDays = 366
+
+	maxDistrictLength = 50
+	maxStreetLength   = 100
+	// maxNearestRadius bounds FindNearest to 1000 km
+	maxNearestRadius = 1e6
+	maxNearestLimit  = 100
+
+	// MaxListLimit is the largest page GetList requests may ask for.
+	MaxListLimit = 1000
+)
+
+// Validate checks req against the rules of its message. Messages without
+// rules are always valid.
+func Validate(req interface{}) error {
+	var v Validator
+
+	switch req := req.(type) {
+	case *organization_service.CreateFilial:
+		v.String("name", req.Name, true, maxNameLength)
+		v.String("address", req.Address, true, maxAddressLength)
+		v.String("phone", req.Phone, true, maxPhoneLength)
+		v.String("region", req.Region, false, maxRegionLength)
+		v.String("filial_code", req.FilialCode, false, maxFilialCodeLength)
+		timeZone(&v, "time_zone", req.TimeZone)
+		v.String("district", req.District, false, maxDistrictLength)
+		v.String("street", req.Street, false, maxStreetLength)
+		location(&v, req.Location)
+	case *organization_service.UpdateFilial:
+		v.UUID("id", req.Id, true)
+		v.String("filial_code", req.FilialCode, true, maxFilialCodeLength)
+		v.String("name", req.Name, true, maxNameLength)
+		v.String("address", req.Address, true, maxAddressLength)
+		v.String("phone", req.Phone, true, maxPhoneLength)
+		v.String("region", req.Region, false, maxRegionLength)
+		timeZone(&v, "time_zone", req.TimeZone)
+		v.String("district", req.District, false, maxDistrictLength)
+		v.String("street", req.Street, false, maxStreetLength)
+		location(&v, req.Location)
+	case *organization_service.UpdatePatchFilial:
+		v.UUID("id", req.Id, true)
+		v.Fields("fields", req.Fields, "filial_code", "name", "address", "phone", "region", "time_zone", "district", "street", "latitude", "longitude")
+	case *organization_service.FilialPK:
+		v.UUID("id", req.Id, true)
+	case *organization_service.GetListFilialRequest:
+		listParams(&v, req.Offset, req.Limit, req.Search)
+	case *organization_service.FindNearestFilialsRequest:
+		location(&v, &organization_service.Location{Latitude: req.Latitude, Longitude: req.Longitude})
+		if !(req.Radius >= 0 && req.Radius <= maxNearestRadius) {
+			v.Violation("radius", "radius must be between 0 and 1e6 meters")
+		}
+		v.Range("limit", req.Limit, 0, maxNearestLimit)
+	case *organization_service.SetWeeklyHoursRequest:
+		scheduleOwner(&v, req.FilialId, req.MagazinId)
+		workingHours(&v, req.Hours)
+	case *organization_service.GetWeeklyHoursRequest:
+		scheduleOwner(&v, req.FilialId, req.MagazinId)
+	case *organization_service.SetScheduleExceptionRequest:
+		scheduleOwner(&v, req.FilialId, req.MagazinId)
+		v.Date("date", req.Date, true)
+		v.String("reason", req.Reason, false, maxReasonLength)
+		if req.Closed {
+			if req.OpensAt != "" || req.ClosesAt != "" {
+				v.Violation("opens_at", "opens_at and closes_at must be empty when closed")
+			}
+		} else if _, err := schedule.ParseInterval(req.OpensAt, req.ClosesAt); err != nil {
+			v.Violation("opens_at", "opens_at and closes_at: "+err.Error())
+		}
+	case *organization_service.ScheduleExceptionPK:
+		v.UUID("id", req.Id, true)
+	case *organization_service.ListScheduleExceptionsRequest:
+		scheduleOwner(&v, req.FilialId, req.MagazinId)
+		v.Date("date_from", req.DateFrom, false)
+		v.Date("date_to", req.DateTo, false)
+	case *organization_service.IsOpenRequest:
+		v.UUID("id", req.Id, true)
+		v.String("at", req.At, false, maxTimestampLength)
+	case *organization_service.GetScheduleRequest:
+		v.UUID("id", req.Id, true)
+		scheduleDates(&v, req.DateFrom, req.DateTo)
+
+	case *organization_service.CreateMagazin:
+		v.String("name", req.Name, true, maxNameLength)
+		v.UUID("filial_id", req.FilialId, true)
+	case *organization_service.UpdateMagazin:
+		v.UUID("id", req.Id, true)
+		v.String("name", req.Name, true, maxNameLength)
+		v.UUID("filial_id", req.FilialId, true)
+	case *organization_service.UpdatePatchMagazin:
+		v.UUID("id", req.Id, true)
+		v.Fields("fields", req.Fields, "name", "filial_id")
+	case *organization_service.MagazinPK:
+		v.UUID("id", req.Id, true)
+	case *organization_service.GetListMagazinRequest:
+		listParams(&v, req.Offset, req.Limit, req.Search)
+
+	case *organization_service.CreateStaff:
+		v.String("first_name", req.FirstName, true, maxPersonNameLength)
+		v.String("last_name", req.LastName, true, maxPersonNameLength)
+		v.String("phone", req.Phone, true, maxPhoneLength)
+		v.String("login", req.Login, true, maxLoginLength)
+		v.String("password", req.Password, true, maxPasswordLength)
+		v.String("staff_type", req.StaffType, true, maxStaffTypeLength)
+		v.UUID("magazin_id", req.MagazinId, true)
+	case *organization_service.UpdateStaff:
+		v.UUID("id", req.Id, true)
+		v.String("first_name", req.FirstName, true, maxPersonNameLength)
+		v.String("last_name", req.LastName, true, maxPersonNameLength)
+		v.String("phone", req.Phone, true, maxPhoneLength)
+		v.String("login", req.Login, true, maxLoginLength)
+		v.String("password", req.Password, true, maxPasswordLength)
+		v.String("staff_type", req.StaffType, true, maxStaffTypeLength)
+		v.UUID("magazin_id", req.MagazinId, true)
+	case *organization_service.UpdatePatchStaff:
+		v.UUID("id", req.Id, true)
+		v.Fields("fields", req.Fields, "first_name", "last_name", "phone", "login", "password", "staff_type", "magazin_id")
+	case *organization_service.StaffPK:
+		v.UUID("id", req.Id, true)
+	case *organization_service.StaffPhoneRequest:
+		v.String("phone", req.Phone, true, maxPhoneLength)
+	case *organization_service.GetListStaffRequest:
+		listParams(&v, req.Offset, req.Limit, req.Search)
+	case *organization_service.StaffStatusRequest:
+		v.UUID("id", req.Id, true)
+		v.String("reason", req.Reason, false, maxReasonLength)
+		v.String("effective_at", req.EffectiveAt, false, maxTimestampLength)
+	case *organization_service.StaffLoginRequest:
+		v.String("login", req.Login, true, maxLoginLength)
+		v.String("password", req.Password, true, maxPasswordLength)
+	case *organization_service.ValidateStaffTokenRequest:
+		v.String("access_token", req.AccessToken, true, maxTokenLength)
+	case *organization_service.RequestPasswordResetRequest:
+		if (req.Login == "") == (req.Phone == "") {
+			v.Violation("login", "exactly one of login and phone is required")
+		}
+		v.String("login", req.Login, false, maxLoginLength)
+		v.String("phone", req.Phone, false, maxPhoneLength)
+	case *organization_service.ConfirmPasswordResetRequest:
+		v.UUID("reset_id", req.ResetId, true)
+		v.String("otp", req.Otp, true, maxOTPLength)
+		v.String("new_password", req.NewPassword, true, maxPasswordLength)
+
+	case *organization_service.CreateProvider:
+		v.String("name", req.Name, true, maxProviderNameLen)
+		v.String("phone", req.Phone, true, maxPhoneLength)
+		providerRequisites(&v, req.LegalName, req.Inn, req.Mfo, req.AccountNumber, req.LegalAddress)
+	case *organization_service.UpdateProvider:
+		v.UUID("id", req.Id, true)
+		v.String("name", req.Name, true, maxProviderNameLen)
+		v.String("phone", req.Phone, true, maxPhoneLength)
+		providerRequisites(&v, req.LegalName, req.Inn, req.Mfo, req.AccountNumber, req.LegalAddress)
+	case *organization_service.UpdatePatchProvider:
+		v.UUID("id", req.Id, true)
+		v.Fields("fields", req.Fields, "name", "phone", "legal_name", "inn", "mfo", "account_number", "vat_payer", "legal_address")
+	case *organization_service.ProviderPK:
+		v.UUID("id", req.Id, true)
+	case *organization_service.ProviderPhoneRequest:
+		v.String("phone", req.Phone, true, maxPhoneLength)
+	case *organization_service.GetListProviderRequest:
+		listParams(&v, req.Offset, req.Limit, req.Search)
+		v.Enum("sort", req.Sort)
+	case *organization_service.ChangeProviderStatusRequest:
+		v.UUID("id", req.Id, true)
+		v.Enum("status", req.Status)
+		if req.Status == organization_service.ProviderStatus_PROVIDER_STATUS_UNSPECIFIED {
+			v.Violation("status", "status is required")
+		}
+		v.String("reason", req.Reason, false, maxReasonLength)
+	case *organization_service.GetProviderStatusHistoryRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		listParams(&v, req.Offset, req.Limit, "")
+	case *organization_service.AddProviderContactRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		providerContact(&v, req.Role, req.Name, req.Phone, req.Email)
+	case *organization_service.UpdateProviderContactRequest:
+		v.UUID("id", req.Id, true)
+		v.UUID("provider_id", req.ProviderId, true)
+		providerContact(&v, req.Role, req.Name, req.Phone, req.Email)
+	case *organization_service.ProviderContactPK:
+		v.UUID("id", req.Id, true)
+		v.UUID("provider_id", req.ProviderId, true)
+	case *organization_service.ListProviderContactsRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+	case *organization_service.AssignProviderRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		v.UUID("magazin_id", req.MagazinId, true)
+		weekdays(&v, "delivery_days", req.DeliveryDays)
+		v.Range("lead_time_days", int64(req.LeadTimeDays), 0, maxLeadTimeDays)
+		v.Date("active_from", req.ActiveFrom, false)
+		v.Date("active_to", req.ActiveTo, false)
+		if req.ActiveFrom != "" && req.ActiveTo != "" && req.ActiveTo < req.ActiveFrom {
+			v.Violation("active_to", "active_to can't be before active_from")
+		}
+	case *organization_service.UnassignProviderRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		v.UUID("magazin_id", req.MagazinId, true)
+	case *organization_service.ListMagazinProvidersRequest:
+		v.UUID("magazin_id", req.MagazinId, true)
+		v.Date("active_on", req.ActiveOn, false)
+		listParams(&v, req.Offset, req.Limit, "")
+	case *organization_service.ListProviderMagazinsRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		v.UUID("filial_id", req.FilialId, false)
+		v.Date("active_on", req.ActiveOn, false)
+		listParams(&v, req.Offset, req.Limit, "")
+	case *organization_service.AddProviderProductRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		v.UUID("product_id", req.ProductId, true)
+		providerProduct(&v, req.SupplierSku, req.PurchasePrice)
+		v.Range("min_order_qty", int64(req.MinOrderQty), 0, maxMinOrderQty)
+	case *organization_service.UpdateProviderProductRequest:
+		v.UUID("id", req.Id, true)
+		v.UUID("provider_id", req.ProviderId, true)
+		providerProduct(&v, req.SupplierSku, req.PurchasePrice)
+		v.Range("min_order_qty", int64(req.MinOrderQty), 1, maxMinOrderQty)
+	case *organization_service.ProviderProductPK:
+		v.UUID("id", req.Id, true)
+		v.UUID("provider_id", req.ProviderId, true)
+	case *organization_service.ListProviderProductsRequest:
+		v.UUID("provider_id", req.ProviderId, false)
+		v.UUID("product_id", req.ProductId, false)
+		if req.ProviderId == "" && req.ProductId == "" {
+			v.Violation("provider_id", "provider_id or product_id is required")
+		}
+		listParams(&v, req.Offset, req.Limit, "")
+	case *organization_service.RecordProviderDeliveryRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		v.UUID("purchase_order_id", req.PurchaseOrderId, false)
+		v.Date("delivered_on", req.DeliveredOn, false)
+		v.Range("days_late", int64(req.DaysLate), 0, maxLeadTimeDays)
+		v.String("comment", req.Comment, false, maxCommentLength)
+	case *organization_service.ListProviderDeliveriesRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		listParams(&v, req.Offset, req.Limit, "")
+	case *organization_service.RateProviderRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		v.Range("rating", int64(req.Rating), 1, maxRating)
+		v.String("comment", req.Comment, false, maxCommentLength)
+	case *organization_service.ListProviderRatingsRequest:
+		v.UUID("provider_id", req.ProviderId, true)
+		listParams(&v, req.Offset, req.Limit, "")
+	case *organization_service.GetProviderScorecardRequest:
+		v.UUID("id", req.Id, true)
+		v.Range("period_days", int64(req.PeriodDays), 0, maxScorePeriodDays)
+	case *organization_service.FindProviderDuplicatesRequest:
+		if !(req.NameSimilarity >= 0 && req.NameSimilarity <= 1) {
+			v.Violation("name_similarity", "name_similarity must be between 0 and 1")
+		}
+		v.Range("limit", req.Limit, 0, MaxListLimit)
+	case *organization_service.MergeProvidersRequest:
+		v.UUID("target_id", req.TargetId, true)
+		mergeSources(&v, req.TargetId, req.SourceIds)
+
+	case *organization_service.CreatePurchaseOrder:
+		v.UUID("magazin_id", req.MagazinId, true)
+		v.UUID("provider_id", req.ProviderId, true)
+		v.Date("expected_delivery_date", req.ExpectedDeliveryDate, false)
+		v.String("comment", req.Comment, false, maxCommentLength)
+		purchaseOrderItems(&v, req.Items)
+	case *organization_service.UpdatePurchaseOrder:
+		v.UUID("id", req.Id, true)
+		v.Date("expected_delivery_date", req.ExpectedDeliveryDate, false)
+		v.String("comment", req.Comment, false, maxCommentLength)
+		purchaseOrderItems(&v, req.Items)
+	case *organization_service.PurchaseOrderPK:
+		v.UUID("id", req.Id, true)
+	case *organization_service.ChangePurchaseOrderStatusRequest:
+		v.UUID("id", req.Id, true)
+		v.Enum("status", req.Status)
+	case *organization_service.GetListPurchaseOrderRequest:
+		listParams(&v, req.Offset, req.Limit, "")
+		v.UUID("magazin_id", req.MagazinId, false)
+		v.UUID("provider_id", req.ProviderId, false)
+		for _, status := range req.Statuses {
+			v.Enum("statuses", status)
+		}
+
+	case *organization_service.CreateWebhook:
+		v.UUID("filial_id", req.FilialId, false)
+		v.String("event_type", req.EventType, true, maxEventTypeLength)
+		v.String("url", req.Url, true, maxURLLength)
+		v.String("secret", req.Secret, false, maxSecretLength)
+	case *organization_service.UpdateWebhook:
+		v.UUID("id", req.Id, true)
+		v.UUID("filial_id", req.FilialId, false)
+		v.String("event_type", req.EventType, true, maxEventTypeLength)
+		v.String("url", req.Url, true, maxURLLength)
+		v.String("secret", req.Secret, false, maxSecretLength)
+	case *organization_service.WebhookPK:
+		v.UUID("id", req.Id, true)
+	case *organization_service.GetListWebhookRequest:
+		listParams(&v, req.Offset, req.Limit, "")
+		v.UUID("filial_id", req.FilialId, false)
+	case *organization_service.GetListWebhookDeliveryRequest:
+		listParams(&v, req.Offset, req.Limit, "")
+		v.UUID("webhook_id", req.WebhookId, false)
+		v.String("status", req.Status, false, maxDeliveryStatusLen)
+	case *organization_service.WebhookDeliveryPK:
+		v.UUID("id", req.Id, true)
+	}
+
+	return v.Err()
+}
+
+// providerRequisites checks the sizes of the legal details of a provider,
+// their format is checked by the service after normalizing them.
+func providerRequisites(v *Validator, legalName, inn, mfo, accountNumber, legalAddress string) {
+	v.String("legal_name", legalName, false, maxLegalNameLength)
+	v.String("inn", inn, false, maxRequisiteLength)
+	v.String("mfo", mfo, false, maxRequisiteLength)
+	v.String("account_number", accountNumber, false, maxRequisiteLength)
+	v.String("legal_address", legalAddress, false, maxLegalAddressLen)
+}
+
+// providerContact checks a contact, which needs a phone or an email.
+func providerContact(v *Validator, role, name, phone, email string) {
+	v.OneOf("role", role, models.ProviderContactSales, models.ProviderContactAccountant, models.ProviderContactWarehouse, models.ProviderContactOther)
+	v.String("name", name, true, maxContactNameLength)
+	v.String("phone", phone, false, maxPhoneLength)
+	v.Email("email", email, false, maxEmailLength)
+	if phone == "" && email == "" {
+		v.Violation("phone", "phone or email is required")
+	}
+}
+
+// providerProduct checks the terms a provider sells a product on.
+func providerProduct(v *Validator, supplierSKU string, purchasePrice float64) {
+	v.String("supplier_sku", supplierSKU, false, maxSupplierSKULength)
+	if !(purchasePrice >= 0 && purchasePrice < maxPurchasePrice) {
+		v.Violation("purchase_price", "purchase_price must be between 0 and 1e12")
+	}
+}
+
+// timeZone checks that value is an IANA time zone, if set.
+func timeZone(v *Validator, field, value string) {
+	v.String(field, value, false, maxTimeZoneLength)
+	if value == "" {
+		return
+	}
+
+	if _, err := time.LoadLocation(value); err != nil || value == "Local" {
+		v.Violation(field, field+" must be an IANA time zone, as Asia/Tashkent")
+	}
+}
+
+// location checks that the coordinates of the location, if set, are on the
+// Earth.
+func location(v *Validator, location *organization_service.Location) {
+	if location == nil {
+		return
+	}
+
+	if !(location.Latitude >= -90 && location.Latitude <= 90) {
+		v.Violation("latitude", "latitude must be between -90 and 90")
+	}
+	if !(location.Longitude >= -180 && location.Longitude <= 180) {
+		v.Violation("longitude", "longitude must be between -180 and 180")
+	}
+}
+
+// scheduleOwner checks that the schedule belongs to either a filial or a
+// magazin.
+func scheduleOwner(v *Validator, filialID, magazinID string) {
+	v.UUID("filial_id", filialID, false)
+	v.UUID("magazin_id", magazinID, false)
+	if (filialID == "") == (magazinID == "") {
+		v.Violation("filial_id", "exactly one of filial_id and magazin_id is required")
+	}
+}
+
+// workingHours checks the weekly hours, which can't overlap on a weekday.
+func workingHours(v *Validator, hours []*organization_service.WorkingHours) {
+	if len(hours) > maxWorkingHours {
+		v.Violation("hours", fmt.Sprintf("hours must have at most %d entries", maxWorkingHours))
+		return
+	}
+
+	var week schedule.Week
+	for n, entry := range hours {
+		field := fmt.Sprintf("hours[%d]", n)
+
+		v.Range(field+".weekday", int64(entry.Weekday), 1, 7)
+		interval, err := schedule.ParseInterval(entry.OpensAt, entry.ClosesAt)
+		if err != nil {
+			v.Violation(field, err.Error())
+			continue
+		}
+
+		if entry.Weekday >= 1 && entry.Weekday <= 7 {
+			week[entry.Weekday] = append(week[entry.Weekday], interval)
+		}
+	}
+
+	for weekday, intervals := range week {
+		if err := schedule.Normalize(intervals); err != nil {
+			v.Violation("hours", fmt.Sprintf("weekday %d: %s", weekday, err))
+		}
+	}
+}
+
+// scheduleDates checks the dates of a schedule, from on or before to and at
+// most maxScheduleDays apart.
+func scheduleDates(v *Validator, from, to string) {
+	v.Date("date_from", from, true)
+	v.Date("date_to", to, true)
+
+	start, err := time.Parse(DateLayout, from)
+	if err != nil {
+		return
+	}
+	end, err := time.Parse(DateLayout, to)
+	if err != nil {
+		return
+	}
+
+	days := int(end.Sub(start).Hours()/24) + 1
+	if days < 1 || days > maxScheduleDays {
+		v.Violation("date_to", fmt.Sprintf("date_to must be from date_from to %d days after it", maxScheduleDays-1))
+	}
+}
+
+// mergeSources checks the providers merged into targetID, each at most once
+// and never the target itself.
+func mergeSources(v *Validator, targetID string, sourceIDs []string) {
+	if len(sourceIDs) == 0 || len(sourceIDs) > maxMergeSources {
+		v.Violation("source_ids", fmt.Sprintf("source_ids must have from 1 to %d entries", maxMergeSources))
+		return
+	}
+
+	seen := make(map[string]bool, len(sourceIDs))
+	for n, id := range sourceIDs {
+		field := fmt.Sprintf("source_ids[%d]", n)
+
+		v.UUID(field, id, true)
+		if id == targetID {
+			v.Violation(field, "a provider can't be merged into itself")
+		}
+		if seen[id] {
+			v.Violation(field, "provider is already merged by another entry")
+		}
+		seen[id] = true
+	}
+}
+
+// purchaseOrderItems checks the items of an order, which orders every product
+// at most once.
+func purchaseOrderItems(v *Validator, items []*organization_service.PurchaseOrderItemInput) {
+	if len(items) == 0 || len(items) > maxPurchaseOrderItems {
+		v.Violation("items", fmt.Sprintf("items must have from 1 to %d entries", maxPurchaseOrderItems))
+		return
+	}
+
+	seen := make(map[string]bool, len(items))
+	for n, item := range items {
+		field := fmt.Sprintf("items[%d]", n)
+
+		v.UUID(field+".product_id", item.ProductId, true)
+		v.Range(field+".quantity", int64(item.Quantity), 1, maxOrderQuantity)
+		if !(item.UnitPrice >= 0 && item.UnitPrice < maxPurchasePrice) {
+			v.Violation(field+".unit_price", "unit_price must be between 0 and 1e12")
+		}
+
+		if seen[item.ProductId] {
+			v.Violation(field+".product_id", "product is already ordered by another item")
+		}
+		seen[item.ProductId] = true
+	}
+}
+
+// weekdays checks that days are distinct ISO weekdays, 1 is Monday and 7
+// Sunday.
+func weekdays(v *Validator, field string, days []int32) {
+	seen := make(map[int32]bool, len(days))
+	for _, day := range days {
+		if day < 1 || day > 7 || seen[day] {
+			v.Violation(field, field+" must be distinct weekdays from 1 to 7")
+			return
+		}
+		seen[day] = true
+	}
+}
+
+func listParams(v *Validator, offset, limit int64, search string) {
+	v.Range("offset", offset, 0, 1<<62)
+	v.Range("limit", limit, 0, MaxListLimit)
+	v.String("search", search, false, maxSearchLength)
+}
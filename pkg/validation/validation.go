@@ -0,0 +1,166 @@
+// Package validation checks requests before they reach the storage. Rules
+// for the messages of the service are in rules.go, violations are reported
+// as codes.InvalidArgument errors with BadRequest details.
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Validator collects the field violations of one request.
+type Validator struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+// Violation records that field is invalid.
+func (v *Validator) Violation(field, description string) {
+	v.violations = append(v.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+// String checks that value is set if required and isn't longer than max
+// characters.
+func (v *Validator) String(field, value string, required bool, max int) {
+	switch {
+	case required && strings.TrimSpace(value) == "":
+		v.Violation(field, field+" is required")
+	case utf8.RuneCountInString(value) > max:
+		v.Violation(field, fmt.Sprintf("%s must be at most %d characters long", field, max))
+	}
+}
+
+// UUID checks that value is a UUID, or empty if it isn't required.
+func (v *Validator) UUID(field, value string, required bool) {
+	if value == "" {
+		if required {
+			v.Violation(field, field+" is required")
+		}
+		return
+	}
+
+	if _, err := uuid.Parse(value); err != nil {
+		v.Violation(field, field+" must be a valid UUID")
+	}
+}
+
+// OneOf checks that value is one of allowed.
+func (v *Validator) OneOf(field, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+
+	v.Violation(field, fmt.Sprintf("%s must be one of %s", field, strings.Join(allowed, ", ")))
+}
+
+// Email checks that value is a bare email address, or empty if it isn't
+// required.
+func (v *Validator) Email(field, value string, required bool, max int) {
+	v.String(field, value, required, max)
+	if value == "" {
+		return
+	}
+
+	if address, err := mail.ParseAddress(value); err != nil || address.Address != value {
+		v.Violation(field, field+" must be a valid email address")
+	}
+}
+
+// DateLayout is the layout of dates in requests.
+const DateLayout = "2006-01-02"
+
+// Date checks that value is a YYYY-MM-DD date, or empty if it isn't required.
+func (v *Validator) Date(field, value string, required bool) {
+	if value == "" {
+		if required {
+			v.Violation(field, field+" is required")
+		}
+		return
+	}
+
+	if _, err := time.Parse(DateLayout, value); err != nil {
+		v.Violation(field, field+" must be a YYYY-MM-DD date")
+	}
+}
+
+// Enum checks that value is one of the values defined for its enum.
+func (v *Validator) Enum(field string, value protoreflect.Enum) {
+	if value.Descriptor().Values().ByNumber(value.Number()) == nil {
+		v.Violation(field, fmt.Sprintf("%s must be one of the %s values", field, value.Descriptor().Name()))
+	}
+}
+
+// Range checks that min <= value <= max.
+func (v *Validator) Range(field string, value, min, max int64) {
+	if value < min || value > max {
+		v.Violation(field, fmt.Sprintf("%s must be between %d and %d", field, min, max))
+	}
+}
+
+// Fields checks the fields of an UpdatePatch request: at least one has to be
+// set and all of them have to be in allowed.
+func (v *Validator) Fields(field string, fields *structpb.Struct, allowed ...string) {
+	if len(fields.GetFields()) == 0 {
+		v.Violation(field, "no updates provided")
+		return
+	}
+
+	keys := make([]string, 0, len(fields.GetFields()))
+	for key := range fields.GetFields() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !contains(allowed, key) {
+			v.Violation(field+"."+key, fmt.Sprintf("%s can't be updated", key))
+		}
+	}
+}
+
+// Err returns nil if there are no violations, an InvalidArgument error with
+// BadRequest details otherwise.
+func (v *Validator) Err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(v.violations))
+	for _, violation := range v.violations {
+		descriptions = append(descriptions, violation.Description)
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(descriptions, "; "))
+
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v.violations})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"organization_service/config"
+	"organization_service/models"
+	"organization_service/pkg/logger"
+	"strconv"
+	"time"
+)
+
+// Store is the part of the webhook repository the dispatcher works with.
+type Store interface {
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	SaveAttempt(context.Context, *models.WebhookDeliveryAttempt) error
+}
+
+// Dispatcher periodically sends due deliveries from the persisted queue,
+// rescheduling failed ones with exponential backoff and moving them to the
+// dead state once MaxAttempts is reached.
+type Dispatcher struct {
+	store  Store
+	log    logger.LoggerI
+	client *http.Client
+
+	MaxAttempts  int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+func NewDispatcher(cfg config.Config, log logger.LoggerI, store Store) *Dispatcher {
+	return &Dispatcher{
+		store:        store,
+		log:          log,
+		client:       &http.Client{Timeout: cfg.WebhookTimeout},
+		MaxAttempts:  cfg.WebhookMaxAttempts,
+		BackoffBase:  cfg.WebhookBackoffBase,
+		BackoffMax:   cfg.WebhookBackoffMax,
+		PollInterval: cfg.WebhookPollInterval,
+		BatchSize:    cfg.WebhookBatchSize,
+	}
+}
+
+// Run sends deliveries until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.DispatchDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue claims one batch of due deliveries and attempts each of them.
+func (d *Dispatcher) DispatchDue(ctx context.Context) {
+	// The lease has to outlive the HTTP attempts of the whole batch,
+	// otherwise another instance could claim the same rows meanwhile.
+	lease := d.client.Timeout*time.Duration(d.BatchSize) + d.PollInterval
+
+	deliveries, err := d.store.ClaimDueDeliveries(ctx, d.BatchSize, lease)
+	if err != nil {
+		d.log.Error("!!!Webhook->ClaimDueDeliveries--->", logger.Error(err))
+		return
+	}
+
+	for _, delivery := range deliveries {
+		attempt := d.attempt(ctx, delivery)
+
+		if err := d.store.SaveAttempt(ctx, attempt); err != nil {
+			d.log.Error("!!!Webhook->SaveAttempt--->", logger.Error(err), logger.String("delivery_id", delivery.Id))
+		}
+	}
+}
+
+func (d *Dispatcher) attempt(ctx context.Context, delivery *models.WebhookDelivery) *models.WebhookDeliveryAttempt {
+	attempt := &models.WebhookDeliveryAttempt{
+		Id:            delivery.Id,
+		Status:        models.WebhookDeliveryDelivered,
+		Attempts:      delivery.Attempts + 1,
+		NextAttemptAt: time.Now(),
+	}
+
+	code, err := d.send(ctx, delivery)
+	attempt.ResponseCode = code
+	if err == nil {
+		return attempt
+	}
+
+	attempt.LastError = err.Error()
+	if attempt.Attempts >= d.MaxAttempts {
+		attempt.Status = models.WebhookDeliveryDead
+		d.log.Warn("Webhook delivery moved to dead letters",
+			logger.String("delivery_id", delivery.Id),
+			logger.String("webhook_id", delivery.WebhookId),
+			logger.Int("attempts", attempt.Attempts),
+			logger.Error(err),
+		)
+		return attempt
+	}
+
+	attempt.Status = models.WebhookDeliveryPending
+	attempt.NextAttemptAt = time.Now().Add(d.Backoff(attempt.Attempts))
+
+	return attempt
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.Id)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Backoff returns how long to wait after the given number of failed attempts.
+func (d *Dispatcher) Backoff(attempts int) time.Duration {
+	backoff := d.BackoffBase
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= d.BackoffMax {
+			return d.BackoffMax
+		}
+	}
+
+	return backoff
+}
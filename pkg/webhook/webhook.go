@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	EventAll = "*"
+
+	EventFilialCreated = "filial.created"
+	EventFilialUpdated = "filial.updated"
+	EventFilialDeleted = "filial.deleted"
+	// EventFilialScheduleUpdated is published when the weekly hours or the
+	// exceptions of a filial or one of its magazins change.
+	EventFilialScheduleUpdated = "filial.schedule_updated"
+
+	EventMagazinCreated = "magazin.created"
+	EventMagazinUpdated = "magazin.updated"
+	EventMagazinDeleted = "magazin.deleted"
+
+	EventStaffCreated = "staff.created"
+	EventStaffUpdated = "staff.updated"
+	EventStaffDeleted = "staff.deleted"
+	// EventStaffLocked is published when a staff is locked out after too
+	// many failed logins.
+	EventStaffLocked = "staff.locked"
+
+	EventProviderCreated = "provider.created"
+	EventProviderUpdated = "provider.updated"
+	EventProviderDeleted = "provider.deleted"
+	// EventProviderMerged is published when providers are merged into
+	// another one.
+	EventProviderMerged = "provider.merged"
+
+	EventPurchaseOrderCreated = "purchase_order.created"
+	EventPurchaseOrderUpdated = "purchase_order.updated"
+	EventPurchaseOrderDeleted = "purchase_order.deleted"
+)
+
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+var events = map[string]bool{
+	EventAll:             true,
+	EventFilialCreated:   true,
+	EventFilialUpdated:   true,
+	EventFilialDeleted:   true,
+	EventMagazinCreated:  true,
+	EventMagazinUpdated:  true,
+	EventMagazinDeleted:  true,
+	EventStaffCreated:    true,
+	EventStaffUpdated:    true,
+	EventStaffDeleted:    true,
+	EventStaffLocked:     true,
+	EventProviderCreated: true,
+	EventProviderUpdated: true,
+	EventProviderDeleted: true,
+	EventProviderMerged:  true,
+
+	EventPurchaseOrderCreated: true,
+	EventPurchaseOrderUpdated: true,
+	EventPurchaseOrderDeleted: true,
+
+	EventFilialScheduleUpdated: true,
+}
+
+// IsKnownEvent reports whether a webhook can subscribe to eventType.
+func IsKnownEvent(eventType string) bool {
+	return events[eventType]
+}
+
+// Payload is the JSON body posted to subscribers.
+type Payload struct {
+	Event      string          `json:"event"`
+	FilialID   string          `json:"filial_id,omitempty"`
+	OccurredAt string          `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewPayload builds the JSON body for an event about data.
+func NewPayload(eventType, filialID string, data proto.Message) ([]byte, error) {
+	body, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(Payload{
+		Event:      eventType,
+		FilialID:   filialID,
+		OccurredAt: time.Now().UTC().Format(time.RFC3339),
+		Data:       body,
+	})
+}
+
+// Sign returns the value of the signature header for body sent at timestamp.
+// Receivers recompute HMAC-SHA256 over "<timestamp>.<body>" with the shared
+// secret and compare it with the header.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is valid for body sent at timestamp.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
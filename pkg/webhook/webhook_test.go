@@ -0,0 +1,161 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"organization_service/models"
+	"organization_service/pkg/logger"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeStore struct {
+	mu         sync.Mutex
+	deliveries []*models.WebhookDelivery
+	attempts   []*models.WebhookDeliveryAttempt
+}
+
+func (s *fakeStore) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	claimed := s.deliveries
+	s.deliveries = nil
+
+	return claimed, nil
+}
+
+func (s *fakeStore) SaveAttempt(ctx context.Context, attempt *models.WebhookDeliveryAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attempts = append(s.attempts, attempt)
+
+	return nil
+}
+
+func newTestDispatcher(store Store) *Dispatcher {
+	return &Dispatcher{
+		store:        store,
+		log:          logger.NewLogger("webhook_test", logger.LevelError, logger.FormatConsole),
+		client:       &http.Client{Timeout: time.Second},
+		MaxAttempts:  3,
+		BackoffBase:  time.Second,
+		BackoffMax:   3 * time.Second,
+		PollInterval: time.Second,
+		BatchSize:    10,
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"event":"filial.created"}`)
+	signature := Sign("secret", 1700000000, body)
+
+	if !Verify("secret", 1700000000, body, signature) {
+		t.Fatalf("signature %q is not valid for its own body", signature)
+	}
+	if Verify("other", 1700000000, body, signature) {
+		t.Fatal("signature is valid for another secret")
+	}
+	if Verify("secret", 1700000001, body, signature) {
+		t.Fatal("signature is valid for another timestamp")
+	}
+}
+
+func TestDispatcherDeliversSignedPayload(t *testing.T) {
+	payload := []byte(`{"event":"provider.created","data":{}}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+
+		if r.Header.Get(HeaderEvent) != EventProviderCreated || r.Header.Get(HeaderDelivery) != "delivery-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !Verify("secret", timestamp, body, r.Header.Get(HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	store := &fakeStore{deliveries: []*models.WebhookDelivery{{
+		Id:        "delivery-1",
+		WebhookId: "webhook-1",
+		EventType: EventProviderCreated,
+		Url:       server.URL,
+		Secret:    "secret",
+		Payload:   payload,
+	}}}
+
+	newTestDispatcher(store).DispatchDue(context.Background())
+
+	if len(store.attempts) != 1 {
+		t.Fatalf("got %d attempts, want 1", len(store.attempts))
+	}
+
+	attempt := store.attempts[0]
+	if attempt.Status != models.WebhookDeliveryDelivered || attempt.ResponseCode != http.StatusNoContent || attempt.Attempts != 1 {
+		t.Fatalf("unexpected attempt %+v", attempt)
+	}
+}
+
+func TestDispatcherRetriesThenDeadLetters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	store := &fakeStore{}
+	dispatcher := newTestDispatcher(store)
+
+	delivery := &models.WebhookDelivery{
+		Id:        "delivery-1",
+		WebhookId: "webhook-1",
+		EventType: EventFilialUpdated,
+		Url:       server.URL,
+		Secret:    "secret",
+		Payload:   []byte(`{}`),
+	}
+
+	for i := 0; i < dispatcher.MaxAttempts; i++ {
+		store.deliveries = []*models.WebhookDelivery{delivery}
+		dispatcher.DispatchDue(context.Background())
+		delivery.Attempts = store.attempts[len(store.attempts)-1].Attempts
+	}
+
+	for i, attempt := range store.attempts[:len(store.attempts)-1] {
+		if attempt.Status != models.WebhookDeliveryPending {
+			t.Fatalf("attempt %d: got status %q, want %q", i+1, attempt.Status, models.WebhookDeliveryPending)
+		}
+		if attempt.ResponseCode != http.StatusServiceUnavailable || attempt.LastError == "" {
+			t.Fatalf("attempt %d: failure not recorded: %+v", i+1, attempt)
+		}
+		if !attempt.NextAttemptAt.After(time.Now()) {
+			t.Fatalf("attempt %d: next attempt is not in the future", i+1)
+		}
+	}
+
+	last := store.attempts[len(store.attempts)-1]
+	if last.Status != models.WebhookDeliveryDead || last.Attempts != dispatcher.MaxAttempts {
+		t.Fatalf("unexpected last attempt %+v", last)
+	}
+}
+
+func TestBackoffIsExponentialAndCapped(t *testing.T) {
+	dispatcher := newTestDispatcher(&fakeStore{})
+
+	want := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second}
+	for i, w := range want {
+		if got := dispatcher.Backoff(i + 1); got != w {
+			t.Fatalf("Backoff(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package storage
+
+import "errors"
+
+// Errors the repositories return for rows that are missing or violate a
+// constraint. They are wrapped in an *Error carrying a readable message.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrReferenceViolation = errors.New("referenced row does not exist")
+	ErrInvalidValue       = errors.New("invalid value")
+)
+
+// Error describes a storage error of kind Err.
+type Error struct {
+	Err     error
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"errors"
+	"organization_service/storage"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+)
+
+// constraintMessages describes the constraints a client can violate.
+var constraintMessages = map[string]string{
+	"filial_filial_code_key":                   "filial code is already taken",
+	"staff_login_key":                          "staff login is already taken",
+	"magazin_filial_id_fkey":                   "filial does not exist",
+	"staff_magazin_id_fkey":                    "magazin does not exist",
+	"webhook_filial_id_fkey":                   "filial does not exist",
+	"staff_status_check":                       "invalid staff status",
+	"provider_status_check":                    "invalid provider status",
+	"provider_inn_key":                         "a provider with this INN already exists",
+	"provider_inn_check":                       "invalid INN",
+	"provider_mfo_check":                       "invalid MFO",
+	"provider_account_number_check":            "invalid account number or missing MFO",
+	"provider_contact_provider_id_fkey":        "provider does not exist",
+	"provider_contact_role_check":              "invalid contact role",
+	"provider_contact_primary_key":             "provider already has a primary contact",
+	"provider_magazin_provider_id_fkey":        "provider does not exist",
+	"provider_magazin_magazin_id_fkey":         "magazin does not exist",
+	"provider_magazin_delivery_days_check":     "delivery days must be weekdays from 1 to 7",
+	"provider_magazin_lead_time_days_check":    "lead time can't be negative",
+	"provider_magazin_active_check":            "active_to can't be before active_from",
+	"provider_product_provider_id_fkey":        "provider does not exist",
+	"provider_product_key":                     "the provider already sells this product",
+	"provider_product_supplier_sku_key":        "supplier SKU is already used by another product of the provider",
+	"provider_product_purchase_price_check":    "purchase price can't be negative",
+	"provider_product_min_order_qty_check":     "minimum order quantity must be at least 1",
+	"purchase_order_order_number_key":          "purchase order number is already taken",
+	"purchase_order_magazin_id_fkey":           "magazin does not exist or has purchase orders",
+	"purchase_order_provider_id_fkey":          "provider does not exist or has purchase orders",
+	"purchase_order_created_by_fkey":           "staff does not exist",
+	"purchase_order_status_check":              "invalid purchase order status",
+	"purchase_order_item_product_key":          "a product can be ordered only once per purchase order",
+	"purchase_order_item_quantity_check":       "quantity must be positive",
+	"purchase_order_item_unit_price_check":     "unit price can't be negative",
+	"provider_delivery_provider_id_fkey":       "provider does not exist",
+	"provider_delivery_purchase_order_id_fkey": "purchase order does not exist",
+	"provider_delivery_purchase_order_key":     "the delivery of this purchase order is already recorded",
+	"provider_delivery_days_late_check":        "days late can't be negative",
+	"provider_delivery_recorded_by_fkey":       "staff does not exist",
+	"provider_rating_rated_by_fkey":            "staff does not exist",
+	"provider_rating_provider_id_fkey":         "provider does not exist",
+	"provider_rating_rating_check":             "rating must be from 1 to 5",
+	"webhook_delivery_status_check":            "invalid webhook delivery status",
+	"working_hours_filial_id_fkey":             "filial does not exist",
+	"working_hours_magazin_id_fkey":            "magazin does not exist",
+	"working_hours_weekday_check":              "weekday must be from 1 to 7",
+	"working_hours_interval_check":             "closing time must be after opening time",
+	"schedule_exception_filial_id_fkey":        "filial does not exist",
+	"schedule_exception_magazin_id_fkey":       "magazin does not exist",
+	"schedule_exception_interval_check":        "closing time must be after opening time",
+	"filial_location_check":                    "latitude and longitude must be set together and within range",
+}
+
+// dbError converts no rows and constraint violations into storage errors,
+// other errors are returned as is.
+func dbError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &storage.Error{Err: storage.ErrNotFound, Message: "not found"}
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	message, ok := constraintMessages[pgErr.ConstraintName]
+	if !ok {
+		message = pgErr.Message
+	}
+
+	switch pgErr.Code {
+	case pgerrcode.UniqueViolation:
+		return &storage.Error{Err: storage.ErrAlreadyExists, Message: message}
+	case pgerrcode.ForeignKeyViolation:
+		return &storage.Error{Err: storage.ErrReferenceViolation, Message: message}
+	case pgerrcode.CheckViolation, pgerrcode.NotNullViolation, pgerrcode.InvalidTextRepresentation, pgerrcode.StringDataRightTruncationDataException:
+		return &storage.Error{Err: storage.ErrInvalidValue, Message: message}
+	default:
+		return err
+	}
+}
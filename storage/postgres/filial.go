@@ -7,14 +7,22 @@ import (
 	"fmt"
 	"organization_service/genproto/organization_service"
 	"organization_service/models"
+	"organization_service/pkg/geo"
 	"organization_service/pkg/helper"
+	"sort"
+	"sync"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type filialRepo struct {
 	db *pgxpool.Pool
+
+	// postgis is nil until the extension was looked up successfully
+	postgisMu sync.Mutex
+	postgis   *bool
 }
 
 func NewFilialRepo(db *pgxpool.Pool) *filialRepo {
@@ -24,9 +32,10 @@ func NewFilialRepo(db *pgxpool.Pool) *filialRepo {
 }
 
 func (c *filialRepo) Create(ctx context.Context, req *organization_service.CreateFilial) (resp *organization_service.FilialPK, err error) {
-	id := uuid.New().String()
+	ctx, end := track(ctx, "filial", "Create")
+	defer end()
 
-	filial_code := helper.CombineFirstLetters(req.Name)
+	id := uuid.New().String()
 
 	query := `
 		INSERT INTO "filial" (
@@ -35,29 +44,44 @@ func (c *filialRepo) Create(ctx context.Context, req *organization_service.Creat
 			name,
 			address,
 			phone,
+			region,
+			time_zone,
+			district,
+			street,
+			latitude,
+			longitude,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
 	`
 
 	_, err = c.db.Exec(
 		ctx,
 		query,
 		id,
-		filial_code,
+		req.FilialCode,
 		req.Name,
 		req.Address,
 		req.Phone,
+		req.Region,
+		req.TimeZone,
+		req.District,
+		req.Street,
+		latitude(req.Location),
+		longitude(req.Location),
 	)
 	if err != nil {
 		fmt.Println(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	return &organization_service.FilialPK{Id: id}, nil
 }
 
 func (c *filialRepo) GetByID(ctx context.Context, req *organization_service.FilialPK) (order *organization_service.Filial, err error) {
+	ctx, end := track(ctx, "filial", "GetByID")
+	defer end()
+
 	query := `
 		SELECT 
 		id,
@@ -65,49 +89,30 @@ func (c *filialRepo) GetByID(ctx context.Context, req *organization_service.Fili
 		name,
 		address,
 		phone,
+		region,
+		time_zone,
+		district,
+		street,
+		latitude,
+		longitude,
 		created_at,
 		updated_at
 		FROM "filial"
 		WHERE id = $1
 	`
 
-	var (
-		id          sql.NullString
-		filial_code sql.NullString
-		name        sql.NullString
-		address     sql.NullString
-		phone       sql.NullString
-		created_at  sql.NullString
-		updated_at  sql.NullString
-	)
-
-	err = c.db.QueryRow(ctx, query, req.Id).Scan(
-		&id,
-		&filial_code,
-		&name,
-		&address,
-		&phone,
-		&created_at,
-		&updated_at,
-	)
+	order, err = scanFilial(c.db.QueryRow(ctx, query, req.Id))
 	if err != nil {
-		return order, err
-	}
-
-	order = &organization_service.Filial{
-		Id:         id.String,
-		FilialCode: filial_code.String,
-		Name:       name.String,
-		Address:    address.String,
-		Phone:      phone.String,
-		CreatedAt:  created_at.String,
-		UpdatedAt:  updated_at.String,
+		return order, dbError(err)
 	}
 
 	return
 }
 
 func (c *filialRepo) GetList(ctx context.Context, req *organization_service.GetListFilialRequest) (resp *organization_service.GetListFilialResponse, err error) {
+	ctx, end := track(ctx, "filial", "GetList")
+	defer end()
+
 	resp = &organization_service.GetListFilialResponse{}
 
 	var (
@@ -127,6 +132,12 @@ func (c *filialRepo) GetList(ctx context.Context, req *organization_service.GetL
 		name,
 		address,
 		phone,
+		region,
+		time_zone,
+		district,
+		street,
+		latitude,
+		longitude,
 		TO_CHAR(created_at, 'YYYY-MM-DD HH24:MI:SS'),
 		TO_CHAR(updated_at, 'YYYY-MM-DD HH24:MI:SS')
 	FROM "filial"
@@ -148,50 +159,26 @@ func (c *filialRepo) GetList(ctx context.Context, req *organization_service.GetL
 
 	rows, err := c.db.Query(ctx, query, args...)
 	if err != nil {
-		return resp, err
+		return resp, dbError(err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var (
-			id          sql.NullString
-			filial_code sql.NullString
-			name        sql.NullString
-			address     sql.NullString
-			phone       sql.NullString
-			created_at  sql.NullString
-			updated_at  sql.NullString
-		)
-
-		err := rows.Scan(
-			&resp.Count,
-			&id,
-			&filial_code,
-			&name,
-			&address,
-			&phone,
-			&created_at,
-			&updated_at,
-		)
+		filial, err := scanFilial(rows, &resp.Count)
 		if err != nil {
-			return resp, err
+			return resp, dbError(err)
 		}
 
-		resp.Filials = append(resp.Filials, &organization_service.Filial{
-			Id:         id.String,
-			FilialCode: filial_code.String,
-			Name:       name.String,
-			Address:    address.String,
-			Phone:      phone.String,
-			CreatedAt:  created_at.String,
-			UpdatedAt:  updated_at.String,
-		})
+		resp.Filials = append(resp.Filials, filial)
 	}
 
 	return
 }
 
 func (c *filialRepo) Update(ctx context.Context, req *organization_service.UpdateFilial) (resp int64, err error) {
+	ctx, end := track(ctx, "filial", "Update")
+	defer end()
+
 	var (
 		query  string
 		params map[string]interface{}
@@ -205,6 +192,12 @@ func (c *filialRepo) Update(ctx context.Context, req *organization_service.Updat
 			name = :name,
 			address = :address,
 			phone = :phone,
+			region = :region,
+			time_zone = COALESCE(NULLIF(:time_zone, ''), time_zone),
+			district = :district,
+			street = :street,
+			latitude = :latitude,
+			longitude = :longitude,
 			updated_at = now()
 		WHERE id = :id
 	`
@@ -214,12 +207,19 @@ func (c *filialRepo) Update(ctx context.Context, req *organization_service.Updat
 		"name":        req.GetName(),
 		"address":     req.GetAddress(),
 		"phone":       req.GetPhone(),
+		"region":      req.GetRegion(),
+		"time_zone":   req.GetTimeZone(),
+		"district":    req.GetDistrict(),
+		"street":      req.GetStreet(),
+		"latitude":    latitude(req.Location),
+		"longitude":   longitude(req.Location),
 	}
 
 	query, args := helper.ReplaceQueryParams(query, params)
 
 	result, err := c.db.Exec(ctx, query, args...)
 	if err != nil {
+		err = dbError(err)
 		return
 	}
 
@@ -227,6 +227,9 @@ func (c *filialRepo) Update(ctx context.Context, req *organization_service.Updat
 }
 
 func (c *filialRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error) {
+	ctx, end := track(ctx, "filial", "UpdatePatch")
+	defer end()
+
 	var (
 		set   = " SET "
 		ind   = 0
@@ -260,6 +263,7 @@ func (c *filialRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchReq
 
 	result, err := c.db.Exec(ctx, query, args...)
 	if err != nil {
+		err = dbError(err)
 		return
 	}
 
@@ -267,12 +271,267 @@ func (c *filialRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchReq
 }
 
 func (c *filialRepo) Delete(ctx context.Context, req *organization_service.FilialPK) error {
+	ctx, end := track(ctx, "filial", "Delete")
+	defer end()
+
 	query := `DELETE FROM "filial" WHERE id = $1`
 
 	_, err := c.db.Exec(ctx, query, req.Id)
 	if err != nil {
-		return err
+		return dbError(err)
 	}
 
 	return nil
 }
+
+// NextCodeSequence reserves and returns the next sequence number of the
+// filial code space key.
+func (c *filialRepo) NextCodeSequence(ctx context.Context, key string) (resp int64, err error) {
+	ctx, end := track(ctx, "filial", "NextCodeSequence")
+	defer end()
+
+	query := `
+		INSERT INTO "filial_code_counter" (key, value)
+		VALUES ($1, 1)
+		ON CONFLICT (key) DO UPDATE SET value = "filial_code_counter".value + 1
+		RETURNING value
+	`
+
+	err = c.db.QueryRow(ctx, query, key).Scan(&resp)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return resp, nil
+}
+
+// PeekCodeSequence returns the sequence number NextCodeSequence would
+// reserve for key, without reserving it.
+func (c *filialRepo) PeekCodeSequence(ctx context.Context, key string) (resp int64, err error) {
+	ctx, end := track(ctx, "filial", "PeekCodeSequence")
+	defer end()
+
+	query := `SELECT COALESCE((SELECT value FROM "filial_code_counter" WHERE key = $1), 0) + 1`
+
+	err = c.db.QueryRow(ctx, query, key).Scan(&resp)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return resp, nil
+}
+
+// FindNearest returns the filials within req.Radius meters of the point of
+// req, nearest first, at most req.Limit of them. Distances are computed by
+// PostGIS when the extension is installed, in Go otherwise.
+func (c *filialRepo) FindNearest(ctx context.Context, req *organization_service.FindNearestFilialsRequest) (resp *organization_service.FindNearestFilialsResponse, err error) {
+	ctx, end := track(ctx, "filial", "FindNearest")
+	defer end()
+
+	if c.hasPostGIS(ctx) {
+		return c.findNearestPostGIS(ctx, req)
+	}
+
+	resp = &organization_service.FindNearestFilialsResponse{}
+
+	box := geo.BoundingBox(req.Latitude, req.Longitude, req.Radius)
+
+	query := `
+		SELECT
+		id,
+		filial_code,
+		name,
+		address,
+		phone,
+		region,
+		time_zone,
+		district,
+		street,
+		latitude,
+		longitude,
+		created_at,
+		updated_at
+		FROM "filial"
+		WHERE latitude BETWEEN $1 AND $2 AND longitude BETWEEN $3 AND $4
+	`
+
+	rows, err := c.db.Query(ctx, query, box.MinLat, box.MaxLat, box.MinLon, box.MaxLon)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		filial, err := scanFilial(rows)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		distance := geo.Distance(req.Latitude, req.Longitude, filial.Location.Latitude, filial.Location.Longitude)
+		if distance > req.Radius {
+			continue
+		}
+
+		resp.Filials = append(resp.Filials, &organization_service.NearbyFilial{
+			Filial:   filial,
+			Distance: distance,
+		})
+	}
+	if err = rows.Err(); err != nil {
+		return resp, dbError(err)
+	}
+
+	sort.SliceStable(resp.Filials, func(a, b int) bool {
+		return resp.Filials[a].Distance < resp.Filials[b].Distance
+	})
+	if len(resp.Filials) > int(req.Limit) {
+		resp.Filials = resp.Filials[:req.Limit]
+	}
+
+	return resp, nil
+}
+
+func (c *filialRepo) findNearestPostGIS(ctx context.Context, req *organization_service.FindNearestFilialsRequest) (resp *organization_service.FindNearestFilialsResponse, err error) {
+	resp = &organization_service.FindNearestFilialsResponse{}
+
+	query := `
+		SELECT
+		ST_Distance(geography(ST_MakePoint(longitude, latitude)), geography(ST_MakePoint($2::float8, $1::float8))) AS distance,
+		id,
+		filial_code,
+		name,
+		address,
+		phone,
+		region,
+		time_zone,
+		district,
+		street,
+		latitude,
+		longitude,
+		created_at,
+		updated_at
+		FROM "filial"
+		WHERE latitude IS NOT NULL
+		AND ST_DWithin(geography(ST_MakePoint(longitude, latitude)), geography(ST_MakePoint($2::float8, $1::float8)), $3::float8)
+		ORDER BY distance
+		LIMIT $4
+	`
+
+	rows, err := c.db.Query(ctx, query, req.Latitude, req.Longitude, req.Radius, req.Limit)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var nearby organization_service.NearbyFilial
+
+		nearby.Filial, err = scanFilial(rows, &nearby.Distance)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Filials = append(resp.Filials, &nearby)
+	}
+
+	return resp, dbError(rows.Err())
+}
+
+// hasPostGIS reports whether the PostGIS extension is installed. The answer
+// is remembered once the lookup succeeds, a failed lookup falls back to
+// computing distances in Go and is retried on the next call.
+func (c *filialRepo) hasPostGIS(ctx context.Context) bool {
+	c.postgisMu.Lock()
+	defer c.postgisMu.Unlock()
+
+	if c.postgis != nil {
+		return *c.postgis
+	}
+
+	var installed bool
+
+	query := `SELECT EXISTS(SELECT 1 FROM pg_extension WHERE extname = 'postgis')`
+
+	if err := c.db.QueryRow(ctx, query).Scan(&installed); err != nil {
+		return false
+	}
+	c.postgis = &installed
+
+	return installed
+}
+
+// scanFilial scans a filial, preceded by the columns in dest if any.
+func scanFilial(row pgx.Row, dest ...interface{}) (*organization_service.Filial, error) {
+	var (
+		id          sql.NullString
+		filial_code sql.NullString
+		name        sql.NullString
+		address     sql.NullString
+		phone       sql.NullString
+		region      sql.NullString
+		time_zone   sql.NullString
+		district    sql.NullString
+		street      sql.NullString
+		latitude    sql.NullFloat64
+		longitude   sql.NullFloat64
+		created_at  sql.NullString
+		updated_at  sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&filial_code,
+		&name,
+		&address,
+		&phone,
+		&region,
+		&time_zone,
+		&district,
+		&street,
+		&latitude,
+		&longitude,
+		&created_at,
+		&updated_at,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	filial := &organization_service.Filial{
+		Id:         id.String,
+		FilialCode: filial_code.String,
+		Name:       name.String,
+		Address:    address.String,
+		Phone:      phone.String,
+		Region:     region.String,
+		TimeZone:   time_zone.String,
+		District:   district.String,
+		Street:     street.String,
+		CreatedAt:  created_at.String,
+		UpdatedAt:  updated_at.String,
+	}
+	if latitude.Valid && longitude.Valid {
+		filial.Location = &organization_service.Location{
+			Latitude:  latitude.Float64,
+			Longitude: longitude.Float64,
+		}
+	}
+
+	return filial, nil
+}
+
+// latitude returns the latitude of the location, nil if it is unset.
+func latitude(location *organization_service.Location) interface{} {
+	if location == nil {
+		return nil
+	}
+	return location.Latitude
+}
+
+// longitude returns the longitude of the location, nil if it is unset.
+func longitude(location *organization_service.Location) interface{} {
+	if location == nil {
+		return nil
+	}
+	return location.Longitude
+}
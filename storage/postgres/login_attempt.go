@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"organization_service/models"
+	"organization_service/pkg/helper"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type loginAttemptRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewLoginAttemptRepo(db *pgxpool.Pool) *loginAttemptRepo {
+	return &loginAttemptRepo{
+		db: db,
+	}
+}
+
+func (c *loginAttemptRepo) Create(ctx context.Context, req *models.LoginAttempt) error {
+	ctx, end := track(ctx, "login_attempt", "Create")
+	defer end()
+
+	query := `
+		INSERT INTO "login_attempt" (
+			id,
+			login,
+			staff_id,
+			client_ip,
+			success,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := c.db.Exec(
+		ctx,
+		query,
+		req.Id,
+		req.Login,
+		helper.NewNullString(req.StaffId),
+		helper.NewNullString(req.ClientIP),
+		req.Success,
+		req.CreatedAt,
+	)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return nil
+}
+
+// CountFailuresByIP returns how many logins from clientIP failed since the
+// given time.
+func (c *loginAttemptRepo) CountFailuresByIP(ctx context.Context, clientIP string, since time.Time) (count int, err error) {
+	ctx, end := track(ctx, "login_attempt", "CountFailuresByIP")
+	defer end()
+
+	query := `SELECT COUNT(*) FROM "login_attempt" WHERE client_ip = $1 AND created_at >= $2 AND NOT success`
+
+	err = c.db.QueryRow(ctx, query, clientIP, since).Scan(&count)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return count, nil
+}
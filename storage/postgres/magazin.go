@@ -24,6 +24,9 @@ func NewMagazinRepo(db *pgxpool.Pool) *magazinRepo {
 }
 
 func (c *magazinRepo) Create(ctx context.Context, req *organization_service.CreateMagazin) (resp *organization_service.MagazinPK, err error) {
+	ctx, end := track(ctx, "magazin", "Create")
+	defer end()
+
 	id := uuid.New().String()
 
 	query := `
@@ -45,13 +48,16 @@ func (c *magazinRepo) Create(ctx context.Context, req *organization_service.Crea
 	)
 	if err != nil {
 		fmt.Println(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	return &organization_service.MagazinPK{Id: id}, nil
 }
 
 func (c *magazinRepo) GetByID(ctx context.Context, req *organization_service.MagazinPK) (order *organization_service.Magazin, err error) {
+	ctx, end := track(ctx, "magazin", "GetByID")
+	defer end()
+
 	query := `
 			SELECT 
 		    m.id,
@@ -79,7 +85,7 @@ func (c *magazinRepo) GetByID(ctx context.Context, req *organization_service.Mag
 		&updated_at,
 	)
 	if err != nil {
-		return order, err
+		return order, dbError(err)
 	}
 
 	order = &organization_service.Magazin{
@@ -94,6 +100,9 @@ func (c *magazinRepo) GetByID(ctx context.Context, req *organization_service.Mag
 }
 
 func (c *magazinRepo) GetList(ctx context.Context, req *organization_service.GetListMagazinRequest) (resp *organization_service.GetListMagazinResponse, err error) {
+	ctx, end := track(ctx, "magazin", "GetList")
+	defer end()
+
 	resp = &organization_service.GetListMagazinResponse{}
 
 	var (
@@ -133,7 +142,7 @@ func (c *magazinRepo) GetList(ctx context.Context, req *organization_service.Get
 
 	rows, err := c.db.Query(ctx, query, args...)
 	if err != nil {
-		return resp, err
+		return resp, dbError(err)
 	}
 	defer rows.Close()
 
@@ -155,7 +164,7 @@ func (c *magazinRepo) GetList(ctx context.Context, req *organization_service.Get
 			&updated_at,
 		)
 		if err != nil {
-			return resp, err
+			return resp, dbError(err)
 		}
 
 		resp.Magazins = append(resp.Magazins, &organization_service.Magazin{
@@ -171,6 +180,9 @@ func (c *magazinRepo) GetList(ctx context.Context, req *organization_service.Get
 }
 
 func (c *magazinRepo) Update(ctx context.Context, req *organization_service.UpdateMagazin) (resp int64, err error) {
+	ctx, end := track(ctx, "magazin", "Update")
+	defer end()
+
 	var (
 		query  string
 		params map[string]interface{}
@@ -195,6 +207,7 @@ func (c *magazinRepo) Update(ctx context.Context, req *organization_service.Upda
 
 	result, err := c.db.Exec(ctx, query, args...)
 	if err != nil {
+		err = dbError(err)
 		return
 	}
 
@@ -202,6 +215,9 @@ func (c *magazinRepo) Update(ctx context.Context, req *organization_service.Upda
 }
 
 func (c *magazinRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error) {
+	ctx, end := track(ctx, "magazin", "UpdatePatch")
+	defer end()
+
 	var (
 		set   = " SET "
 		ind   = 0
@@ -235,6 +251,7 @@ func (c *magazinRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRe
 
 	result, err := c.db.Exec(ctx, query, args...)
 	if err != nil {
+		err = dbError(err)
 		return
 	}
 
@@ -242,11 +259,14 @@ func (c *magazinRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRe
 }
 
 func (c *magazinRepo) Delete(ctx context.Context, req *organization_service.MagazinPK) error {
+	ctx, end := track(ctx, "magazin", "Delete")
+	defer end()
+
 	query := `DELETE FROM "magazin" WHERE id = $1`
 
 	_, err := c.db.Exec(ctx, query, req.Id)
 	if err != nil {
-		return err
+		return dbError(err)
 	}
 
 	return nil
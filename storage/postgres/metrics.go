@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"organization_service/pkg/metrics"
+	"organization_service/pkg/tracing"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// track starts a span for a repository method and measures its duration.
+// It is meant to be called at the top of the method:
+//
+//	ctx, end := track(ctx, "filial", "Create")
+//	defer end()
+func track(ctx context.Context, repo, method string) (context.Context, func()) {
+	start := time.Now()
+
+	ctx, span := tracing.Tracer().Start(ctx, repo+"."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBSQLTable(repo),
+			semconv.DBOperation(method),
+		),
+	)
+
+	return ctx, func() {
+		span.End()
+		metrics.QueryDuration.WithLabelValues(repo, method).Observe(time.Since(start).Seconds())
+	}
+}
+
+var (
+	poolAcquiredConns = prometheus.NewDesc("organization_service_db_pool_acquired_conns", "Number of currently acquired connections in the pool.", nil, nil)
+	poolIdleConns     = prometheus.NewDesc("organization_service_db_pool_idle_conns", "Number of currently idle connections in the pool.", nil, nil)
+	poolTotalConns    = prometheus.NewDesc("organization_service_db_pool_total_conns", "Total number of connections currently in the pool.", nil, nil)
+	poolMaxConns      = prometheus.NewDesc("organization_service_db_pool_max_conns", "Maximum size of the pool.", nil, nil)
+	poolAcquireCount  = prometheus.NewDesc("organization_service_db_pool_acquire_total", "Cumulative count of successful acquires from the pool.", nil, nil)
+	poolEmptyAcquire  = prometheus.NewDesc("organization_service_db_pool_empty_acquire_total", "Cumulative count of acquires that had to wait for a connection.", nil, nil)
+	poolCanceled      = prometheus.NewDesc("organization_service_db_pool_canceled_acquire_total", "Cumulative count of acquires canceled by a context.", nil, nil)
+	poolAcquireWait   = prometheus.NewDesc("organization_service_db_pool_acquire_wait_seconds_total", "Cumulative time spent acquiring connections from the pool.", nil, nil)
+
+	staffByFilial     = prometheus.NewDesc("organization_service_staff", "Number of staff per filial.", []string{"filial_id"}, nil)
+	providersByStatus = prometheus.NewDesc("organization_service_providers", "Number of providers per status.", []string{"status"}, nil)
+)
+
+// poolCollector exports pgxpool statistics on every scrape.
+type poolCollector struct {
+	pool *pgxpool.Pool
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolAcquiredConns
+	ch <- poolIdleConns
+	ch <- poolTotalConns
+	ch <- poolMaxConns
+	ch <- poolAcquireCount
+	ch <- poolEmptyAcquire
+	ch <- poolCanceled
+	ch <- poolAcquireWait
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(poolAcquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(poolIdleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(poolTotalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(poolMaxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(poolAcquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolEmptyAcquire, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolCanceled, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(poolAcquireWait, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
+
+// businessCollector exports gauges computed from the data on every scrape.
+type businessCollector struct {
+	pool    *pgxpool.Pool
+	timeout time.Duration
+}
+
+func (c *businessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- staffByFilial
+	ch <- providersByStatus
+}
+
+func (c *businessCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	c.collect(ctx, ch, staffByFilial, `
+		SELECT
+			f.id::TEXT,
+			COUNT(s.id)
+		FROM "filial" AS f
+		LEFT JOIN "magazin" AS m ON m.filial_id = f.id
+		LEFT JOIN "staff" AS s ON s.magazin_id = m.id
+		GROUP BY f.id
+	`)
+
+	c.collect(ctx, ch, providersByStatus, `
+		SELECT
+			status::TEXT,
+			COUNT(*)
+		FROM "provider"
+		GROUP BY status
+	`)
+}
+
+// collect exports one gauge per row of query, which must select a label
+// value and a count.
+func (c *businessCollector) collect(ctx context.Context, ch chan<- prometheus.Metric, desc *prometheus.Desc, query string) {
+	rows, err := c.pool.Query(ctx, query)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(desc, err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			label string
+			count int64
+		)
+
+		if err := rows.Scan(&label, &count); err != nil {
+			ch <- prometheus.NewInvalidMetric(desc, err)
+			return
+		}
+
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(count), label)
+	}
+
+	if err := rows.Err(); err != nil {
+		ch <- prometheus.NewInvalidMetric(desc, err)
+	}
+}
+
+func registerMetrics(pool *pgxpool.Pool) error {
+	return metrics.Register(
+		&poolCollector{pool: pool},
+		&businessCollector{pool: pool, timeout: 5 * time.Second},
+	)
+}
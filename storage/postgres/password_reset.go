@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"organization_service/models"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type passwordResetRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPasswordResetRepo(db *pgxpool.Pool) *passwordResetRepo {
+	return &passwordResetRepo{
+		db: db,
+	}
+}
+
+func (c *passwordResetRepo) Create(ctx context.Context, req *models.PasswordReset) error {
+	ctx, end := track(ctx, "password_reset", "Create")
+	defer end()
+
+	query := `
+		INSERT INTO "password_reset" (
+			id,
+			staff_id,
+			phone,
+			otp_hash,
+			expires_at,
+			created_at
+		) VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := c.db.Exec(
+		ctx,
+		query,
+		req.Id,
+		req.StaffId,
+		req.Phone,
+		req.OtpHash,
+		req.ExpiresAt,
+		req.CreatedAt,
+	)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return nil
+}
+
+func (c *passwordResetRepo) GetByID(ctx context.Context, id string) (*models.PasswordReset, error) {
+	ctx, end := track(ctx, "password_reset", "GetByID")
+	defer end()
+
+	query := `
+		SELECT
+			id,
+			staff_id,
+			phone,
+			otp_hash,
+			attempts,
+			consumed_at,
+			expires_at,
+			created_at
+		FROM "password_reset"
+		WHERE id = $1
+	`
+
+	var (
+		reset       models.PasswordReset
+		consumed_at sql.NullTime
+	)
+
+	err := c.db.QueryRow(ctx, query, id).Scan(
+		&reset.Id,
+		&reset.StaffId,
+		&reset.Phone,
+		&reset.OtpHash,
+		&reset.Attempts,
+		&consumed_at,
+		&reset.ExpiresAt,
+		&reset.CreatedAt,
+	)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	reset.Consumed = consumed_at.Valid
+
+	return &reset, nil
+}
+
+// CountSince returns how many resets were requested for phone since the
+// given time and when the last one was.
+func (c *passwordResetRepo) CountSince(ctx context.Context, phone string, since time.Time) (count int, last time.Time, err error) {
+	ctx, end := track(ctx, "password_reset", "CountSince")
+	defer end()
+
+	query := `
+		SELECT
+			COUNT(*),
+			MAX(created_at)
+		FROM "password_reset"
+		WHERE phone = $1 AND created_at >= $2
+	`
+
+	var lastCreatedAt sql.NullTime
+
+	err = c.db.QueryRow(ctx, query, phone, since).Scan(&count, &lastCreatedAt)
+	if err != nil {
+		return 0, time.Time{}, dbError(err)
+	}
+
+	return count, lastCreatedAt.Time, nil
+}
+
+// RecordRequest records a reset requested for key, the login or the phone
+// asked for, whether it belongs to a staff or not.
+func (c *passwordResetRepo) RecordRequest(ctx context.Context, key string, at time.Time) error {
+	ctx, end := track(ctx, "password_reset_request", "RecordRequest")
+	defer end()
+
+	query := `INSERT INTO "password_reset_request" (id, key, created_at) VALUES ($1, $2, $3)`
+
+	_, err := c.db.Exec(ctx, query, uuid.New().String(), key, at)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return nil
+}
+
+// CountRequestsSince returns how many resets were requested for key since
+// the given time and when the last one was.
+func (c *passwordResetRepo) CountRequestsSince(ctx context.Context, key string, since time.Time) (count int, last time.Time, err error) {
+	ctx, end := track(ctx, "password_reset_request", "CountRequestsSince")
+	defer end()
+
+	query := `
+		SELECT
+			COUNT(*),
+			MAX(created_at)
+		FROM "password_reset_request"
+		WHERE key = $1 AND created_at >= $2
+	`
+
+	var lastCreatedAt sql.NullTime
+
+	err = c.db.QueryRow(ctx, query, key, since).Scan(&count, &lastCreatedAt)
+	if err != nil {
+		return 0, time.Time{}, dbError(err)
+	}
+
+	return count, lastCreatedAt.Time, nil
+}
+
+// IncrementAttempts takes one of the max attempts of a reset that isn't
+// consumed yet and returns how many are taken. It returns
+// storage.ErrNotFound when none is left, so that concurrent tries can't get
+// past max.
+func (c *passwordResetRepo) IncrementAttempts(ctx context.Context, id string, max int) (attempts int, err error) {
+	ctx, end := track(ctx, "password_reset", "IncrementAttempts")
+	defer end()
+
+	query := `
+		UPDATE "password_reset" SET attempts = attempts + 1
+		WHERE id = $1 AND consumed_at IS NULL AND attempts < $2
+		RETURNING attempts
+	`
+
+	err = c.db.QueryRow(ctx, query, id, max).Scan(&attempts)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return attempts, nil
+}
+
+// Consume marks the reset as used and sets the new password of the staff.
+// It affects no rows if the reset was consumed meanwhile.
+func (c *passwordResetRepo) Consume(ctx context.Context, id, staffID, passwordHash string) (resp int64, err error) {
+	ctx, end := track(ctx, "password_reset", "Consume")
+	defer end()
+
+	err = c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, `UPDATE "password_reset" SET consumed_at = NOW() WHERE id = $1 AND consumed_at IS NULL`, id)
+		if err != nil {
+			return err
+		}
+
+		resp = result.RowsAffected()
+		if resp == 0 {
+			return nil
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE "staff" SET password = $2 WHERE id = $1`, staffID, passwordHash)
+
+		return err
+	})
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return resp, nil
+}
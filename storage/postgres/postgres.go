@@ -15,6 +15,18 @@ type Store struct {
 	magazin  storage.MagazinRepoI
 	staff    storage.StaffRepoI
 	provider storage.ProviderRepoI
+	webhook  storage.WebhookRepoI
+
+	passwordReset storage.PasswordResetRepoI
+	loginAttempt  storage.LoginAttemptRepoI
+
+	providerContact storage.ProviderContactRepoI
+	providerMagazin storage.ProviderMagazinRepoI
+	providerProduct storage.ProviderProductRepoI
+	providerPerf    storage.ProviderPerformanceRepoI
+	purchaseOrder   storage.PurchaseOrderRepoI
+
+	schedule storage.ScheduleRepoI
 }
 
 func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
@@ -37,12 +49,29 @@ func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, erro
 		return nil, err
 	}
 
+	if err = registerMetrics(pool); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
 	return &Store{
 		db:       pool,
 		filial:   NewFilialRepo(pool),
 		magazin:  NewMagazinRepo(pool),
 		staff:    NewStaffRepo(pool),
 		provider: NewProviderRepo(pool),
+		webhook:  NewWebhookRepo(pool),
+
+		passwordReset: NewPasswordResetRepo(pool),
+		loginAttempt:  NewLoginAttemptRepo(pool),
+
+		providerContact: NewProviderContactRepo(pool),
+		providerMagazin: NewProviderMagazinRepo(pool),
+		providerProduct: NewProviderProductRepo(pool),
+		providerPerf:    NewProviderPerformanceRepo(pool),
+		purchaseOrder:   NewPurchaseOrderRepo(pool),
+
+		schedule: NewScheduleRepo(pool),
 	}, nil
 }
 
@@ -50,6 +79,10 @@ func (s *Store) CloseDB() {
 	s.db.Close()
 }
 
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.Ping(ctx)
+}
+
 func (s *Store) Filial() storage.FilialRepoI {
 	if s.filial == nil {
 		s.filial = NewFilialRepo(s.db)
@@ -77,3 +110,66 @@ func (s *Store) Provider() storage.ProviderRepoI {
 	}
 	return s.provider
 }
+
+func (s *Store) Webhook() storage.WebhookRepoI {
+	if s.webhook == nil {
+		s.webhook = NewWebhookRepo(s.db)
+	}
+	return s.webhook
+}
+
+func (s *Store) PasswordReset() storage.PasswordResetRepoI {
+	if s.passwordReset == nil {
+		s.passwordReset = NewPasswordResetRepo(s.db)
+	}
+	return s.passwordReset
+}
+
+func (s *Store) LoginAttempt() storage.LoginAttemptRepoI {
+	if s.loginAttempt == nil {
+		s.loginAttempt = NewLoginAttemptRepo(s.db)
+	}
+	return s.loginAttempt
+}
+
+func (s *Store) ProviderContact() storage.ProviderContactRepoI {
+	if s.providerContact == nil {
+		s.providerContact = NewProviderContactRepo(s.db)
+	}
+	return s.providerContact
+}
+
+func (s *Store) ProviderMagazin() storage.ProviderMagazinRepoI {
+	if s.providerMagazin == nil {
+		s.providerMagazin = NewProviderMagazinRepo(s.db)
+	}
+	return s.providerMagazin
+}
+
+func (s *Store) ProviderProduct() storage.ProviderProductRepoI {
+	if s.providerProduct == nil {
+		s.providerProduct = NewProviderProductRepo(s.db)
+	}
+	return s.providerProduct
+}
+
+func (s *Store) ProviderPerformance() storage.ProviderPerformanceRepoI {
+	if s.providerPerf == nil {
+		s.providerPerf = NewProviderPerformanceRepo(s.db)
+	}
+	return s.providerPerf
+}
+
+func (s *Store) PurchaseOrder() storage.PurchaseOrderRepoI {
+	if s.purchaseOrder == nil {
+		s.purchaseOrder = NewPurchaseOrderRepo(s.db)
+	}
+	return s.purchaseOrder
+}
+
+func (s *Store) Schedule() storage.ScheduleRepoI {
+	if s.schedule == nil {
+		s.schedule = NewScheduleRepo(s.db)
+	}
+	return s.schedule
+}
@@ -8,8 +8,10 @@ import (
 	"organization_service/genproto/organization_service"
 	"organization_service/models"
 	"organization_service/pkg/helper"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -24,6 +26,9 @@ func NewProviderRepo(db *pgxpool.Pool) *providerRepo {
 }
 
 func (c *providerRepo) Create(ctx context.Context, req *organization_service.CreateProvider) (resp *organization_service.ProviderPK, err error) {
+	ctx, end := track(ctx, "provider", "Create")
+	defer end()
+
 	id := uuid.New().String()
 
 	query := `
@@ -31,9 +36,15 @@ func (c *providerRepo) Create(ctx context.Context, req *organization_service.Cre
 			id,
 			name,
 			phone,
+			legal_name,
+			inn,
+			mfo,
+			account_number,
+			vat_payer,
+			legal_address,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, NOW(), NOW())
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
 	`
 
 	_, err = c.db.Exec(
@@ -42,16 +53,25 @@ func (c *providerRepo) Create(ctx context.Context, req *organization_service.Cre
 		id,
 		req.Name,
 		req.Phone,
+		helper.NewNullString(req.LegalName),
+		helper.NewNullString(req.Inn),
+		helper.NewNullString(req.Mfo),
+		helper.NewNullString(req.AccountNumber),
+		req.VatPayer,
+		helper.NewNullString(req.LegalAddress),
 	)
 	if err != nil {
 		fmt.Println(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	return &organization_service.ProviderPK{Id: id}, nil
 }
 
 func (c *providerRepo) GetByID(ctx context.Context, req *organization_service.ProviderPK) (Provider *organization_service.Provider, err error) {
+	ctx, end := track(ctx, "provider", "GetByID")
+	defer end()
+
 	query := `
 		SELECT 
 		    id,
@@ -59,7 +79,13 @@ func (c *providerRepo) GetByID(ctx context.Context, req *organization_service.Pr
 			phone,
 			status,
 			created_at,
-			updated_at
+			updated_at,
+			legal_name,
+			inn,
+			mfo,
+			account_number,
+			vat_payer,
+			legal_address
 		FROM "provider"
 		WHERE id = $1;
 	`
@@ -70,6 +96,13 @@ func (c *providerRepo) GetByID(ctx context.Context, req *organization_service.Pr
 		status     sql.NullInt32
 		created_at sql.NullString
 		updated_at sql.NullString
+
+		legal_name     sql.NullString
+		inn            sql.NullString
+		mfo            sql.NullString
+		account_number sql.NullString
+		vat_payer      sql.NullBool
+		legal_address  sql.NullString
 	)
 
 	err = c.db.QueryRow(ctx, query, req.Id).Scan(
@@ -79,24 +112,42 @@ func (c *providerRepo) GetByID(ctx context.Context, req *organization_service.Pr
 		&status,
 		&created_at,
 		&updated_at,
+		&legal_name,
+		&inn,
+		&mfo,
+		&account_number,
+		&vat_payer,
+		&legal_address,
 	)
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	Provider = &organization_service.Provider{
 		Id:        id.String,
 		Name:      name.String,
 		Phone:     phone.String,
-		Status:    status.Int32,
+		Status:    organization_service.ProviderStatus(status.Int32),
 		CreatedAt: created_at.String,
 		UpdatedAt: updated_at.String,
+
+		LegalName:     legal_name.String,
+		Inn:           inn.String,
+		Mfo:           mfo.String,
+		AccountNumber: account_number.String,
+		VatPayer:      vat_payer.Bool,
+		LegalAddress:  legal_address.String,
 	}
 
 	return
 }
 
-func (c *providerRepo) GetList(ctx context.Context, req *organization_service.GetListProviderRequest) (resp *organization_service.GetListProviderResponse, err error) {
+// GetList returns the providers, sorted by their score since scoreSince if
+// req asks so.
+func (c *providerRepo) GetList(ctx context.Context, req *organization_service.GetListProviderRequest, scoreSince time.Time) (resp *organization_service.GetListProviderResponse, err error) {
+	ctx, end := track(ctx, "provider", "GetList")
+	defer end()
+
 	resp = &organization_service.GetListProviderResponse{}
 
 	var (
@@ -106,8 +157,17 @@ func (c *providerRepo) GetList(ctx context.Context, req *organization_service.Ge
 		params = make(map[string]interface{})
 		filter = " WHERE TRUE "
 		sort   = " ORDER BY created_at DESC"
+		score  = "NULL::float8"
+		join   = ""
 	)
 
+	if req.GetSort() == organization_service.ProviderSort_PROVIDER_SORT_SCORE {
+		score = providerScoreColumn
+		join = providerStatsJoin
+		sort = " ORDER BY score DESC NULLS LAST, created_at DESC"
+		params["score_since"] = scoreSince
+	}
+
 	query = `
 	   SELECT 
 	   		COUNT(*) OVER(),
@@ -116,9 +176,16 @@ func (c *providerRepo) GetList(ctx context.Context, req *organization_service.Ge
 			   phone,
 			   status,
 			   created_at,
-			   updated_at
-		FROM "provider" 
-	`
+			   updated_at,
+			   legal_name,
+			   inn,
+			   mfo,
+			   account_number,
+			   vat_payer,
+			   legal_address,
+			   ` + score + ` AS score
+		FROM "provider" AS p
+	` + join
 	if len(req.GetSearch()) > 0 {
 		filter += " AND name ILIKE '%' || '" + req.Search + "' || '%' "
 	}
@@ -136,7 +203,7 @@ func (c *providerRepo) GetList(ctx context.Context, req *organization_service.Ge
 
 	rows, err := c.db.Query(ctx, query, args...)
 	if err != nil {
-		return resp, err
+		return resp, dbError(err)
 	}
 	defer rows.Close()
 
@@ -148,6 +215,14 @@ func (c *providerRepo) GetList(ctx context.Context, req *organization_service.Ge
 			status     sql.NullInt32
 			created_at sql.NullString
 			updated_at sql.NullString
+
+			legal_name     sql.NullString
+			inn            sql.NullString
+			mfo            sql.NullString
+			account_number sql.NullString
+			vat_payer      sql.NullBool
+			legal_address  sql.NullString
+			score          sql.NullFloat64
 		)
 
 		err := rows.Scan(
@@ -158,18 +233,33 @@ func (c *providerRepo) GetList(ctx context.Context, req *organization_service.Ge
 			&status,
 			&created_at,
 			&updated_at,
+			&legal_name,
+			&inn,
+			&mfo,
+			&account_number,
+			&vat_payer,
+			&legal_address,
+			&score,
 		)
 		if err != nil {
-			return resp, err
+			return resp, dbError(err)
 		}
 
 		resp.Providers = append(resp.Providers, &organization_service.Provider{
 			Id:        id.String,
 			Name:      name.String,
 			Phone:     phone.String,
-			Status:    status.Int32,
+			Status:    organization_service.ProviderStatus(status.Int32),
 			CreatedAt: created_at.String,
 			UpdatedAt: updated_at.String,
+
+			LegalName:     legal_name.String,
+			Inn:           inn.String,
+			Mfo:           mfo.String,
+			AccountNumber: account_number.String,
+			VatPayer:      vat_payer.Bool,
+			LegalAddress:  legal_address.String,
+			Score:         score.Float64,
 		})
 	}
 
@@ -177,6 +267,9 @@ func (c *providerRepo) GetList(ctx context.Context, req *organization_service.Ge
 }
 
 func (c *providerRepo) Update(ctx context.Context, req *organization_service.UpdateProvider) (resp int64, err error) {
+	ctx, end := track(ctx, "provider", "Update")
+	defer end()
+
 	var (
 		query  string
 		params map[string]interface{}
@@ -188,21 +281,32 @@ func (c *providerRepo) Update(ctx context.Context, req *organization_service.Upd
 		SET
 			name = :name,
 			phone= :phone,
-			status = :status,
+			legal_name = :legal_name,
+			inn = :inn,
+			mfo = :mfo,
+			account_number = :account_number,
+			vat_payer = :vat_payer,
+			legal_address = :legal_address,
 			updated_at = now()
 		WHERE id = :id
 	`
 	params = map[string]interface{}{
-		"id":     req.GetId(),
-		"name":   req.GetName(),
-		"phone":  req.GetPhone(),
-		"status": req.GetStatus(),
+		"id":             req.GetId(),
+		"name":           req.GetName(),
+		"phone":          req.GetPhone(),
+		"legal_name":     helper.NewNullString(req.GetLegalName()),
+		"inn":            helper.NewNullString(req.GetInn()),
+		"mfo":            helper.NewNullString(req.GetMfo()),
+		"account_number": helper.NewNullString(req.GetAccountNumber()),
+		"vat_payer":      req.GetVatPayer(),
+		"legal_address":  helper.NewNullString(req.GetLegalAddress()),
 	}
 
 	query, args := helper.ReplaceQueryParams(query, params)
 
 	result, err := c.db.Exec(ctx, query, args...)
 	if err != nil {
+		err = dbError(err)
 		return
 	}
 
@@ -210,6 +314,9 @@ func (c *providerRepo) Update(ctx context.Context, req *organization_service.Upd
 }
 
 func (c *providerRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error) {
+	ctx, end := track(ctx, "provider", "UpdatePatch")
+	defer end()
+
 	var (
 		set   = " SET "
 		ind   = 0
@@ -243,6 +350,7 @@ func (c *providerRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchR
 
 	result, err := c.db.Exec(ctx, query, args...)
 	if err != nil {
+		err = dbError(err)
 		return
 	}
 
@@ -250,12 +358,264 @@ func (c *providerRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchR
 }
 
 func (c *providerRepo) Delete(ctx context.Context, req *organization_service.ProviderPK) error {
+	ctx, end := track(ctx, "provider", "Delete")
+	defer end()
+
 	query := `DELETE FROM "provider" WHERE id = $1`
 
 	_, err := c.db.Exec(ctx, query, req.Id)
 	if err != nil {
+		return dbError(err)
+	}
+
+	return nil
+}
+
+// GetByPhone returns the most recently created provider with the given phone.
+func (c *providerRepo) GetByPhone(ctx context.Context, req *organization_service.ProviderPhoneRequest) (*organization_service.Provider, error) {
+	ctx, end := track(ctx, "provider", "GetByPhone")
+	defer end()
+
+	query := `SELECT id FROM "provider" WHERE phone = $1 ORDER BY created_at DESC LIMIT 1`
+
+	var id string
+
+	err := c.db.QueryRow(ctx, query, req.Phone).Scan(&id)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return c.GetByID(ctx, &organization_service.ProviderPK{Id: id})
+}
+
+// ChangeStatus applies the status change and records it in the history. It
+// affects no rows if the provider is no longer in status req.From.
+func (c *providerRepo) ChangeStatus(ctx context.Context, req *models.ProviderStatusChange) (resp int64, err error) {
+	ctx, end := track(ctx, "provider", "ChangeStatus")
+	defer end()
+
+	err = c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, `UPDATE "provider" SET status = $2 WHERE id = $1 AND status = $3`,
+			req.ProviderId,
+			int32(req.To),
+			int32(req.From),
+		)
+		if err != nil {
+			return err
+		}
+
+		resp = result.RowsAffected()
+		if resp == 0 {
+			return nil
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "provider_status_history" (
+				id,
+				provider_id,
+				from_status,
+				to_status,
+				reason,
+				changed_by,
+				created_at
+			) VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		`,
+			uuid.New().String(),
+			req.ProviderId,
+			int32(req.From),
+			int32(req.To),
+			helper.NewNullString(req.Reason),
+			helper.NewNullString(req.ChangedBy),
+		)
+
+		return err
+	})
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return resp, nil
+}
+
+func (c *providerRepo) GetStatusHistory(ctx context.Context, req *organization_service.GetProviderStatusHistoryRequest) (resp *organization_service.GetProviderStatusHistoryResponse, err error) {
+	ctx, end := track(ctx, "provider", "GetStatusHistory")
+	defer end()
+
+	resp = &organization_service.GetProviderStatusHistoryResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = map[string]interface{}{"provider_id": req.ProviderId}
+		filter = " WHERE provider_id = :provider_id "
+		sort   = " ORDER BY created_at DESC"
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),
+			id,
+			provider_id,
+			from_status,
+			to_status,
+			reason,
+			changed_by,
+			created_at
+		FROM "provider_status_history"
+	`
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id          sql.NullString
+			provider_id sql.NullString
+			from_status sql.NullInt32
+			to_status   sql.NullInt32
+			reason      sql.NullString
+			changed_by  sql.NullString
+			created_at  sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&provider_id,
+			&from_status,
+			&to_status,
+			&reason,
+			&changed_by,
+			&created_at,
+		)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.History = append(resp.History, &organization_service.ProviderStatusHistory{
+			Id:         id.String,
+			ProviderId: provider_id.String,
+			FromStatus: organization_service.ProviderStatus(from_status.Int32),
+			ToStatus:   organization_service.ProviderStatus(to_status.Int32),
+			Reason:     reason.String,
+			ChangedBy:  changed_by.String,
+			CreatedAt:  created_at.String,
+		})
+	}
+
+	return
+}
+
+// providerMergeStatements move the rows of the source providers ($2) to the
+// target one ($1). Rows that would clash with a row of the target or of a
+// source earlier in id order are dropped first: the target keeps its
+// assignments, catalog entries, ratings and primary contact.
+var providerMergeStatements = []string{
+	`UPDATE "provider_contact" SET is_primary = FALSE WHERE provider_id = ANY($2::uuid[]) AND is_primary`,
+	`UPDATE "provider_contact" SET provider_id = $1 WHERE provider_id = ANY($2::uuid[])`,
+
+	`DELETE FROM "provider_magazin" AS pm WHERE pm.provider_id = ANY($2::uuid[]) AND EXISTS (
+		SELECT 1 FROM "provider_magazin" AS o
+		WHERE o.magazin_id = pm.magazin_id AND (o.provider_id = $1 OR (o.provider_id = ANY($2::uuid[]) AND o.id < pm.id))
+	)`,
+	`UPDATE "provider_magazin" SET provider_id = $1 WHERE provider_id = ANY($2::uuid[])`,
+
+	`DELETE FROM "provider_product" AS pp WHERE pp.provider_id = ANY($2::uuid[]) AND EXISTS (
+		SELECT 1 FROM "provider_product" AS o
+		WHERE o.product_id = pp.product_id AND (o.provider_id = $1 OR (o.provider_id = ANY($2::uuid[]) AND o.id < pp.id))
+	)`,
+	`UPDATE "provider_product" AS pp SET supplier_sku = NULL WHERE pp.provider_id = ANY($2::uuid[]) AND EXISTS (
+		SELECT 1 FROM "provider_product" AS o
+		WHERE o.supplier_sku = pp.supplier_sku AND (o.provider_id = $1 OR (o.provider_id = ANY($2::uuid[]) AND o.id < pp.id))
+	)`,
+	`UPDATE "provider_product" SET provider_id = $1 WHERE provider_id = ANY($2::uuid[])`,
+
+	`DELETE FROM "provider_rating" AS pr WHERE pr.provider_id = ANY($2::uuid[]) AND EXISTS (
+		SELECT 1 FROM "provider_rating" AS o
+		WHERE o.rated_by = pr.rated_by AND (o.provider_id = $1 OR (o.provider_id = ANY($2::uuid[]) AND o.id < pr.id))
+	)`,
+	`UPDATE "provider_rating" SET provider_id = $1 WHERE provider_id = ANY($2::uuid[])`,
+
+	`UPDATE "provider_delivery" SET provider_id = $1 WHERE provider_id = ANY($2::uuid[])`,
+	`UPDATE "provider_status_history" SET provider_id = $1 WHERE provider_id = ANY($2::uuid[])`,
+	`UPDATE "purchase_order" SET provider_id = $1 WHERE provider_id = ANY($2::uuid[])`,
+	`UPDATE "provider_redirect" SET provider_id = $1 WHERE provider_id = ANY($2::uuid[])`,
+}
+
+// Merge moves everything referencing the source providers to the target one,
+// leaves a redirect for each source and deletes them, all or nothing. It
+// returns a not found error if any of the providers doesn't exist.
+func (c *providerRepo) Merge(ctx context.Context, req *models.ProviderMerge) error {
+	ctx, end := track(ctx, "provider", "Merge")
+	defer end()
+
+	err := c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var locked int
+
+		err := tx.QueryRow(ctx, `
+			SELECT COUNT(*) FROM (
+				SELECT id FROM "provider" WHERE id = $1 OR id = ANY($2::uuid[]) FOR UPDATE
+			) AS p
+		`, req.TargetId, req.SourceIds).Scan(&locked)
+		if err != nil {
+			return err
+		}
+		if locked != len(req.SourceIds)+1 {
+			return pgx.ErrNoRows
+		}
+
+		for _, statement := range providerMergeStatements {
+			if _, err = tx.Exec(ctx, statement, req.TargetId, req.SourceIds); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "provider_redirect" (old_id, provider_id, merged_by, created_at)
+			SELECT old_id, $1, $3, NOW() FROM UNNEST($2::uuid[]) AS old_id
+		`, req.TargetId, req.SourceIds, helper.NewNullString(req.MergedBy))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `DELETE FROM "provider" WHERE id = ANY($1::uuid[])`, req.SourceIds)
+
 		return err
+	})
+	if err != nil {
+		return dbError(err)
 	}
 
 	return nil
 }
+
+// GetRedirect returns the id of the provider the provider oldID was merged
+// into.
+func (c *providerRepo) GetRedirect(ctx context.Context, oldID string) (string, error) {
+	ctx, end := track(ctx, "provider_redirect", "GetRedirect")
+	defer end()
+
+	var id string
+
+	err := c.db.QueryRow(ctx, `SELECT provider_id FROM "provider_redirect" WHERE old_id = $1`, oldID).Scan(&id)
+	if err != nil {
+		return "", dbError(err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"organization_service/genproto/organization_service"
+	"organization_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type providerContactRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewProviderContactRepo(db *pgxpool.Pool) *providerContactRepo {
+	return &providerContactRepo{
+		db: db,
+	}
+}
+
+// Create adds the contact. A primary contact replaces the previous primary
+// contact of the provider.
+func (c *providerContactRepo) Create(ctx context.Context, req *organization_service.AddProviderContactRequest) (resp *organization_service.ProviderContactPK, err error) {
+	ctx, end := track(ctx, "provider_contact", "Create")
+	defer end()
+
+	id := uuid.New().String()
+
+	err = c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if req.IsPrimary {
+			if err := clearPrimaryContact(ctx, tx, req.ProviderId); err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO "provider_contact" (
+				id,
+				provider_id,
+				role,
+				name,
+				phone,
+				email,
+				is_primary,
+				created_at,
+				updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		`,
+			id,
+			req.ProviderId,
+			req.Role,
+			req.Name,
+			helper.NewNullString(req.Phone),
+			helper.NewNullString(req.Email),
+			req.IsPrimary,
+		)
+
+		return err
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return &organization_service.ProviderContactPK{Id: id, ProviderId: req.ProviderId}, nil
+}
+
+func (c *providerContactRepo) GetByID(ctx context.Context, req *organization_service.ProviderContactPK) (*organization_service.ProviderContact, error) {
+	ctx, end := track(ctx, "provider_contact", "GetByID")
+	defer end()
+
+	query := `
+		SELECT
+			id,
+			provider_id,
+			role,
+			name,
+			phone,
+			email,
+			is_primary,
+			created_at,
+			updated_at
+		FROM "provider_contact"
+		WHERE id = $1 AND provider_id = $2
+	`
+
+	contact, err := scanProviderContact(c.db.QueryRow(ctx, query, req.Id, req.ProviderId))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return contact, nil
+}
+
+// GetList returns the contacts of the provider, the primary one first.
+func (c *providerContactRepo) GetList(ctx context.Context, req *organization_service.ListProviderContactsRequest) (resp *organization_service.ListProviderContactsResponse, err error) {
+	ctx, end := track(ctx, "provider_contact", "GetList")
+	defer end()
+
+	resp = &organization_service.ListProviderContactsResponse{}
+
+	query := `
+		SELECT
+			id,
+			provider_id,
+			role,
+			name,
+			phone,
+			email,
+			is_primary,
+			created_at,
+			updated_at
+		FROM "provider_contact"
+		WHERE provider_id = $1
+		ORDER BY is_primary DESC, created_at
+	`
+
+	rows, err := c.db.Query(ctx, query, req.ProviderId)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		contact, err := scanProviderContact(rows)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Contacts = append(resp.Contacts, contact)
+	}
+
+	return resp, dbError(rows.Err())
+}
+
+// Update changes the contact. A primary contact replaces the previous primary
+// contact of the provider.
+func (c *providerContactRepo) Update(ctx context.Context, req *organization_service.UpdateProviderContactRequest) (resp int64, err error) {
+	ctx, end := track(ctx, "provider_contact", "Update")
+	defer end()
+
+	err = c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if req.IsPrimary {
+			if err := clearPrimaryContact(ctx, tx, req.ProviderId); err != nil {
+				return err
+			}
+		}
+
+		result, err := tx.Exec(ctx, `
+			UPDATE
+				"provider_contact"
+			SET
+				role = $3,
+				name = $4,
+				phone = $5,
+				email = $6,
+				is_primary = $7
+			WHERE id = $1 AND provider_id = $2
+		`,
+			req.Id,
+			req.ProviderId,
+			req.Role,
+			req.Name,
+			helper.NewNullString(req.Phone),
+			helper.NewNullString(req.Email),
+			req.IsPrimary,
+		)
+		if err != nil {
+			return err
+		}
+
+		resp = result.RowsAffected()
+
+		return nil
+	})
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return resp, nil
+}
+
+func (c *providerContactRepo) Delete(ctx context.Context, req *organization_service.ProviderContactPK) error {
+	ctx, end := track(ctx, "provider_contact", "Delete")
+	defer end()
+
+	query := `DELETE FROM "provider_contact" WHERE id = $1 AND provider_id = $2`
+
+	_, err := c.db.Exec(ctx, query, req.Id, req.ProviderId)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return nil
+}
+
+func clearPrimaryContact(ctx context.Context, tx pgx.Tx, providerID string) error {
+	_, err := tx.Exec(ctx, `UPDATE "provider_contact" SET is_primary = FALSE WHERE provider_id = $1 AND is_primary`, providerID)
+	return err
+}
+
+func scanProviderContact(row pgx.Row) (*organization_service.ProviderContact, error) {
+	var (
+		id          sql.NullString
+		provider_id sql.NullString
+		role        sql.NullString
+		name        sql.NullString
+		phone       sql.NullString
+		email       sql.NullString
+		is_primary  sql.NullBool
+		created_at  sql.NullString
+		updated_at  sql.NullString
+	)
+
+	err := row.Scan(
+		&id,
+		&provider_id,
+		&role,
+		&name,
+		&phone,
+		&email,
+		&is_primary,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization_service.ProviderContact{
+		Id:         id.String,
+		ProviderId: provider_id.String,
+		Role:       role.String,
+		Name:       name.String,
+		Phone:      phone.String,
+		Email:      email.String,
+		IsPrimary:  is_primary.Bool,
+		CreatedAt:  created_at.String,
+		UpdatedAt:  updated_at.String,
+	}, nil
+}
@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"organization_service/genproto/organization_service"
+	"organization_service/models"
+	"organization_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type providerMagazinRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewProviderMagazinRepo(db *pgxpool.Pool) *providerMagazinRepo {
+	return &providerMagazinRepo{
+		db: db,
+	}
+}
+
+// Upsert assigns the provider to the magazin, or replaces the terms of an
+// existing assignment.
+func (c *providerMagazinRepo) Upsert(ctx context.Context, req *organization_service.AssignProviderRequest) error {
+	ctx, end := track(ctx, "provider_magazin", "Upsert")
+	defer end()
+
+	query := `
+		INSERT INTO "provider_magazin" (
+			id,
+			provider_id,
+			magazin_id,
+			delivery_days,
+			lead_time_days,
+			active_from,
+			active_to,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, COALESCE($6::date, CURRENT_DATE), $7::date, NOW(), NOW())
+		ON CONFLICT (provider_id, magazin_id) DO UPDATE SET
+			delivery_days = EXCLUDED.delivery_days,
+			lead_time_days = EXCLUDED.lead_time_days,
+			active_from = EXCLUDED.active_from,
+			active_to = EXCLUDED.active_to
+	`
+
+	deliveryDays := req.DeliveryDays
+	if deliveryDays == nil {
+		deliveryDays = []int32{}
+	}
+
+	_, err := c.db.Exec(
+		ctx,
+		query,
+		uuid.New().String(),
+		req.ProviderId,
+		req.MagazinId,
+		deliveryDays,
+		req.LeadTimeDays,
+		helper.NewNullString(req.ActiveFrom),
+		helper.NewNullString(req.ActiveTo),
+	)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return nil
+}
+
+func (c *providerMagazinRepo) Get(ctx context.Context, providerID, magazinID string) (*organization_service.ProviderAssignment, error) {
+	ctx, end := track(ctx, "provider_magazin", "Get")
+	defer end()
+
+	resp, err := c.GetList(ctx, &models.ProviderAssignmentFilter{ProviderId: providerID, MagazinId: magazinID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Assignments) == 0 {
+		return nil, dbError(pgx.ErrNoRows)
+	}
+
+	return resp.Assignments[0], nil
+}
+
+func (c *providerMagazinRepo) Delete(ctx context.Context, req *organization_service.UnassignProviderRequest) (int64, error) {
+	ctx, end := track(ctx, "provider_magazin", "Delete")
+	defer end()
+
+	query := `DELETE FROM "provider_magazin" WHERE provider_id = $1 AND magazin_id = $2`
+
+	result, err := c.db.Exec(ctx, query, req.ProviderId, req.MagazinId)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *providerMagazinRepo) GetList(ctx context.Context, req *models.ProviderAssignmentFilter) (resp *organization_service.ListProviderAssignmentsResponse, err error) {
+	ctx, end := track(ctx, "provider_magazin", "GetList")
+	defer end()
+
+	resp = &organization_service.ListProviderAssignmentsResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY p.name, m.name"
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),
+			pm.id,
+			pm.provider_id,
+			p.name,
+			pm.magazin_id,
+			m.name,
+			m.filial_id,
+			pm.delivery_days,
+			pm.lead_time_days,
+			TO_CHAR(pm.active_from, 'YYYY-MM-DD'),
+			TO_CHAR(pm.active_to, 'YYYY-MM-DD'),
+			pm.created_at,
+			pm.updated_at
+		FROM "provider_magazin" AS pm
+		JOIN "provider" AS p ON p.id = pm.provider_id
+		JOIN "magazin" AS m ON m.id = pm.magazin_id
+	`
+	if req.ProviderId != "" {
+		filter += " AND pm.provider_id = :provider_id "
+		params["provider_id"] = req.ProviderId
+	}
+	if req.MagazinId != "" {
+		filter += " AND pm.magazin_id = :magazin_id "
+		params["magazin_id"] = req.MagazinId
+	}
+	if req.FilialId != "" {
+		filter += " AND m.filial_id = :filial_id "
+		params["filial_id"] = req.FilialId
+	}
+	if req.ActiveOn != "" {
+		filter += " AND pm.active_from <= :active_on::date AND (pm.active_to IS NULL OR pm.active_to >= :active_on::date) "
+		params["active_on"] = req.ActiveOn
+	}
+	if req.Limit > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.Offset > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id             sql.NullString
+			provider_id    sql.NullString
+			provider_name  sql.NullString
+			magazin_id     sql.NullString
+			magazin_name   sql.NullString
+			filial_id      sql.NullString
+			delivery_days  []int32
+			lead_time_days sql.NullInt32
+			active_from    sql.NullString
+			active_to      sql.NullString
+			created_at     sql.NullString
+			updated_at     sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&provider_id,
+			&provider_name,
+			&magazin_id,
+			&magazin_name,
+			&filial_id,
+			&delivery_days,
+			&lead_time_days,
+			&active_from,
+			&active_to,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Assignments = append(resp.Assignments, &organization_service.ProviderAssignment{
+			Id:           id.String,
+			ProviderId:   provider_id.String,
+			ProviderName: provider_name.String,
+			MagazinId:    magazin_id.String,
+			MagazinName:  magazin_name.String,
+			FilialId:     filial_id.String,
+			DeliveryDays: delivery_days,
+			LeadTimeDays: lead_time_days.Int32,
+			ActiveFrom:   active_from.String,
+			ActiveTo:     active_to.String,
+			CreatedAt:    created_at.String,
+			UpdatedAt:    updated_at.String,
+		})
+	}
+
+	return
+}
@@ -0,0 +1,407 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"organization_service/genproto/organization_service"
+	"organization_service/pkg/helper"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type providerPerformanceRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewProviderPerformanceRepo(db *pgxpool.Pool) *providerPerformanceRepo {
+	return &providerPerformanceRepo{
+		db: db,
+	}
+}
+
+// providerStatsJoin joins to "provider" AS p the deliveries (pd) and the
+// ratings (pr) of each provider since :score_since.
+const providerStatsJoin = `
+		LEFT JOIN (
+			SELECT
+				provider_id,
+				COUNT(*) AS deliveries,
+				COUNT(*) FILTER (WHERE days_late = 0) AS on_time,
+				COUNT(*) FILTER (WHERE days_late > 0) AS late,
+				COUNT(*) FILTER (WHERE short_shipped) AS short_shipped,
+				COUNT(*) FILTER (WHERE quality_issue) AS quality_issues
+			FROM "provider_delivery"
+			WHERE delivered_on >= :score_since::date
+			GROUP BY provider_id
+		) AS pd ON pd.provider_id = p.id
+		LEFT JOIN (
+			SELECT
+				provider_id,
+				COUNT(*) AS ratings,
+				AVG(rating) AS average_rating
+			FROM "provider_rating"
+			WHERE updated_at >= :score_since
+			GROUP BY provider_id
+		) AS pr ON pr.provider_id = p.id
+	`
+
+// providerScoreColumn is the score of a provider from providerStatsJoin, NULL
+// if it has no deliveries and no ratings.
+const providerScoreColumn = `provider_score(pd.deliveries, pd.on_time, pd.short_shipped, pd.quality_issues, pr.ratings, pr.average_rating)::float8`
+
+func (c *providerPerformanceRepo) CreateDelivery(ctx context.Context, req *organization_service.RecordProviderDeliveryRequest, recordedBy string) (*organization_service.ProviderDelivery, error) {
+	ctx, end := track(ctx, "provider_delivery", "CreateDelivery")
+	defer end()
+
+	query := `
+		INSERT INTO "provider_delivery" (
+			id,
+			provider_id,
+			purchase_order_id,
+			delivered_on,
+			days_late,
+			short_shipped,
+			quality_issue,
+			comment,
+			recorded_by,
+			created_at
+		) VALUES ($1, $2, $3, COALESCE($4::date, CURRENT_DATE), $5, $6, $7, $8, $9, NOW())
+		RETURNING
+			id,
+			provider_id,
+			purchase_order_id,
+			TO_CHAR(delivered_on, 'YYYY-MM-DD'),
+			days_late,
+			short_shipped,
+			quality_issue,
+			comment,
+			recorded_by,
+			created_at
+	`
+
+	delivery, err := scanProviderDelivery(c.db.QueryRow(
+		ctx,
+		query,
+		uuid.New().String(),
+		req.ProviderId,
+		helper.NewNullString(req.PurchaseOrderId),
+		helper.NewNullString(req.DeliveredOn),
+		req.DaysLate,
+		req.ShortShipped,
+		req.QualityIssue,
+		helper.NewNullString(req.Comment),
+		helper.NewNullString(recordedBy),
+	))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return delivery, nil
+}
+
+// GetDeliveryList returns the deliveries of the provider, the latest first.
+func (c *providerPerformanceRepo) GetDeliveryList(ctx context.Context, req *organization_service.ListProviderDeliveriesRequest) (resp *organization_service.ListProviderDeliveriesResponse, err error) {
+	ctx, end := track(ctx, "provider_delivery", "GetDeliveryList")
+	defer end()
+
+	resp = &organization_service.ListProviderDeliveriesResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = map[string]interface{}{"provider_id": req.ProviderId}
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),
+			id,
+			provider_id,
+			purchase_order_id,
+			TO_CHAR(delivered_on, 'YYYY-MM-DD'),
+			days_late,
+			short_shipped,
+			quality_issue,
+			comment,
+			recorded_by,
+			created_at
+		FROM "provider_delivery"
+		WHERE provider_id = :provider_id
+		ORDER BY delivered_on DESC, created_at DESC
+	`
+	if req.Limit > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.Offset > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		delivery, err := scanProviderDelivery(rows, &resp.Count)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Deliveries = append(resp.Deliveries, delivery)
+	}
+
+	return resp, dbError(rows.Err())
+}
+
+// UpsertRating rates the provider, replacing the previous rating of the same
+// staff.
+func (c *providerPerformanceRepo) UpsertRating(ctx context.Context, req *organization_service.RateProviderRequest, ratedBy string) (*organization_service.ProviderRating, error) {
+	ctx, end := track(ctx, "provider_rating", "UpsertRating")
+	defer end()
+
+	query := `
+		INSERT INTO "provider_rating" (
+			id,
+			provider_id,
+			rating,
+			comment,
+			rated_by,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		ON CONFLICT (provider_id, rated_by) DO UPDATE SET
+			rating = EXCLUDED.rating,
+			comment = EXCLUDED.comment
+		RETURNING
+			id,
+			provider_id,
+			rating,
+			comment,
+			rated_by,
+			created_at,
+			updated_at
+	`
+
+	rating, err := scanProviderRating(c.db.QueryRow(
+		ctx,
+		query,
+		uuid.New().String(),
+		req.ProviderId,
+		req.Rating,
+		helper.NewNullString(req.Comment),
+		ratedBy,
+	))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return rating, nil
+}
+
+// GetRatingList returns the ratings of the provider, the latest first.
+func (c *providerPerformanceRepo) GetRatingList(ctx context.Context, req *organization_service.ListProviderRatingsRequest) (resp *organization_service.ListProviderRatingsResponse, err error) {
+	ctx, end := track(ctx, "provider_rating", "GetRatingList")
+	defer end()
+
+	resp = &organization_service.ListProviderRatingsResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = map[string]interface{}{"provider_id": req.ProviderId}
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),
+			id,
+			provider_id,
+			rating,
+			comment,
+			rated_by,
+			created_at,
+			updated_at
+		FROM "provider_rating"
+		WHERE provider_id = :provider_id
+		ORDER BY updated_at DESC
+	`
+	if req.Limit > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.Offset > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rating, err := scanProviderRating(rows, &resp.Count)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Ratings = append(resp.Ratings, rating)
+	}
+
+	return resp, dbError(rows.Err())
+}
+
+// GetScorecard sums up the deliveries and the ratings of the provider since
+// the day of since. The period fields are left to the caller.
+func (c *providerPerformanceRepo) GetScorecard(ctx context.Context, providerID string, since time.Time) (*organization_service.ProviderScorecard, error) {
+	ctx, end := track(ctx, "provider_delivery", "GetScorecard")
+	defer end()
+
+	query := `
+		SELECT
+			p.id,
+			COALESCE(pd.deliveries, 0),
+			COALESCE(pd.on_time, 0),
+			COALESCE(pd.late, 0),
+			COALESCE(pd.short_shipped, 0),
+			COALESCE(pd.quality_issues, 0),
+			COALESCE(pr.ratings, 0),
+			pr.average_rating::float8,
+			` + providerScoreColumn + `
+		FROM "provider" AS p
+		` + providerStatsJoin + `
+		WHERE p.id = :provider_id
+	`
+
+	query, args := helper.ReplaceQueryParams(query, map[string]interface{}{
+		"provider_id": providerID,
+		"score_since": since,
+	})
+
+	var (
+		resp           organization_service.ProviderScorecard
+		average_rating sql.NullFloat64
+		score          sql.NullFloat64
+	)
+
+	err := c.db.QueryRow(ctx, query, args...).Scan(
+		&resp.ProviderId,
+		&resp.Deliveries,
+		&resp.OnTime,
+		&resp.Late,
+		&resp.ShortShipped,
+		&resp.QualityIssues,
+		&resp.Ratings,
+		&average_rating,
+		&score,
+	)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	if resp.Deliveries > 0 {
+		resp.OnTimeRate = float64(resp.OnTime) / float64(resp.Deliveries)
+	}
+	resp.AverageRating = average_rating.Float64
+	resp.HasScore = score.Valid
+	resp.Score = score.Float64
+
+	return &resp, nil
+}
+
+// scanProviderDelivery scans a delivery, preceded by the columns in dest if
+// any.
+func scanProviderDelivery(row pgx.Row, dest ...interface{}) (*organization_service.ProviderDelivery, error) {
+	var (
+		id                sql.NullString
+		provider_id       sql.NullString
+		purchase_order_id sql.NullString
+		delivered_on      sql.NullString
+		days_late         sql.NullInt32
+		short_shipped     sql.NullBool
+		quality_issue     sql.NullBool
+		comment           sql.NullString
+		recorded_by       sql.NullString
+		created_at        sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&provider_id,
+		&purchase_order_id,
+		&delivered_on,
+		&days_late,
+		&short_shipped,
+		&quality_issue,
+		&comment,
+		&recorded_by,
+		&created_at,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization_service.ProviderDelivery{
+		Id:              id.String,
+		ProviderId:      provider_id.String,
+		PurchaseOrderId: purchase_order_id.String,
+		DeliveredOn:     delivered_on.String,
+		DaysLate:        days_late.Int32,
+		ShortShipped:    short_shipped.Bool,
+		QualityIssue:    quality_issue.Bool,
+		Comment:         comment.String,
+		RecordedBy:      recorded_by.String,
+		CreatedAt:       created_at.String,
+	}, nil
+}
+
+// scanProviderRating scans a rating, preceded by the columns in dest if any.
+func scanProviderRating(row pgx.Row, dest ...interface{}) (*organization_service.ProviderRating, error) {
+	var (
+		id          sql.NullString
+		provider_id sql.NullString
+		rating      sql.NullInt32
+		comment     sql.NullString
+		rated_by    sql.NullString
+		created_at  sql.NullString
+		updated_at  sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&provider_id,
+		&rating,
+		&comment,
+		&rated_by,
+		&created_at,
+		&updated_at,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization_service.ProviderRating{
+		Id:         id.String,
+		ProviderId: provider_id.String,
+		Rating:     rating.Int32,
+		Comment:    comment.String,
+		RatedBy:    rated_by.String,
+		CreatedAt:  created_at.String,
+		UpdatedAt:  updated_at.String,
+	}, nil
+}
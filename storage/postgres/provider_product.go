@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"organization_service/genproto/organization_service"
+	"organization_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type providerProductRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewProviderProductRepo(db *pgxpool.Pool) *providerProductRepo {
+	return &providerProductRepo{
+		db: db,
+	}
+}
+
+func (c *providerProductRepo) Create(ctx context.Context, req *organization_service.AddProviderProductRequest) (resp *organization_service.ProviderProductPK, err error) {
+	ctx, end := track(ctx, "provider_product", "Create")
+	defer end()
+
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "provider_product" (
+			id,
+			provider_id,
+			product_id,
+			supplier_sku,
+			purchase_price,
+			min_order_qty,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		req.ProviderId,
+		req.ProductId,
+		helper.NewNullString(req.SupplierSku),
+		req.PurchasePrice,
+		req.MinOrderQty,
+	)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return &organization_service.ProviderProductPK{Id: id, ProviderId: req.ProviderId}, nil
+}
+
+func (c *providerProductRepo) GetByID(ctx context.Context, req *organization_service.ProviderProductPK) (*organization_service.ProviderProduct, error) {
+	ctx, end := track(ctx, "provider_product", "GetByID")
+	defer end()
+
+	query := `
+		SELECT
+			id,
+			provider_id,
+			product_id,
+			supplier_sku,
+			purchase_price::float8,
+			min_order_qty,
+			created_at,
+			updated_at
+		FROM "provider_product"
+		WHERE id = $1 AND provider_id = $2
+	`
+
+	product, err := scanProviderProduct(c.db.QueryRow(ctx, query, req.Id, req.ProviderId))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return product, nil
+}
+
+// GetList returns the products of a provider or the providers of a product,
+// the cheapest offer first.
+func (c *providerProductRepo) GetList(ctx context.Context, req *organization_service.ListProviderProductsRequest) (resp *organization_service.ListProviderProductsResponse, err error) {
+	ctx, end := track(ctx, "provider_product", "GetList")
+	defer end()
+
+	resp = &organization_service.ListProviderProductsResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY purchase_price, created_at"
+	)
+
+	query = `
+		SELECT
+			COUNT(*) OVER(),
+			id,
+			provider_id,
+			product_id,
+			supplier_sku,
+			purchase_price::float8,
+			min_order_qty,
+			created_at,
+			updated_at
+		FROM "provider_product"
+	`
+	if req.ProviderId != "" {
+		filter += " AND provider_id = :provider_id "
+		params["provider_id"] = req.ProviderId
+	}
+	if req.ProductId != "" {
+		filter += " AND product_id = :product_id "
+		params["product_id"] = req.ProductId
+	}
+	if req.Limit > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.Offset > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id             sql.NullString
+			provider_id    sql.NullString
+			product_id     sql.NullString
+			supplier_sku   sql.NullString
+			purchase_price sql.NullFloat64
+			min_order_qty  sql.NullInt32
+			created_at     sql.NullString
+			updated_at     sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&provider_id,
+			&product_id,
+			&supplier_sku,
+			&purchase_price,
+			&min_order_qty,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Products = append(resp.Products, &organization_service.ProviderProduct{
+			Id:            id.String,
+			ProviderId:    provider_id.String,
+			ProductId:     product_id.String,
+			SupplierSku:   supplier_sku.String,
+			PurchasePrice: purchase_price.Float64,
+			MinOrderQty:   min_order_qty.Int32,
+			CreatedAt:     created_at.String,
+			UpdatedAt:     updated_at.String,
+		})
+	}
+
+	return resp, dbError(rows.Err())
+}
+
+func (c *providerProductRepo) Update(ctx context.Context, req *organization_service.UpdateProviderProductRequest) (resp int64, err error) {
+	ctx, end := track(ctx, "provider_product", "Update")
+	defer end()
+
+	query := `
+		UPDATE
+			"provider_product"
+		SET
+			supplier_sku = $3,
+			purchase_price = $4,
+			min_order_qty = $5
+		WHERE id = $1 AND provider_id = $2
+	`
+
+	result, err := c.db.Exec(
+		ctx,
+		query,
+		req.Id,
+		req.ProviderId,
+		helper.NewNullString(req.SupplierSku),
+		req.PurchasePrice,
+		req.MinOrderQty,
+	)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *providerProductRepo) Delete(ctx context.Context, req *organization_service.ProviderProductPK) (int64, error) {
+	ctx, end := track(ctx, "provider_product", "Delete")
+	defer end()
+
+	query := `DELETE FROM "provider_product" WHERE id = $1 AND provider_id = $2`
+
+	result, err := c.db.Exec(ctx, query, req.Id, req.ProviderId)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func scanProviderProduct(row pgx.Row) (*organization_service.ProviderProduct, error) {
+	var (
+		id             sql.NullString
+		provider_id    sql.NullString
+		product_id     sql.NullString
+		supplier_sku   sql.NullString
+		purchase_price sql.NullFloat64
+		min_order_qty  sql.NullInt32
+		created_at     sql.NullString
+		updated_at     sql.NullString
+	)
+
+	err := row.Scan(
+		&id,
+		&provider_id,
+		&product_id,
+		&supplier_sku,
+		&purchase_price,
+		&min_order_qty,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization_service.ProviderProduct{
+		Id:            id.String,
+		ProviderId:    provider_id.String,
+		ProductId:     product_id.String,
+		SupplierSku:   supplier_sku.String,
+		PurchasePrice: purchase_price.Float64,
+		MinOrderQty:   min_order_qty.Int32,
+		CreatedAt:     created_at.String,
+		UpdatedAt:     updated_at.String,
+	}, nil
+}
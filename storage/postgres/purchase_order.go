@@ -0,0 +1,395 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"organization_service/genproto/organization_service"
+	"organization_service/models"
+	"organization_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type purchaseOrderRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPurchaseOrderRepo(db *pgxpool.Pool) *purchaseOrderRepo {
+	return &purchaseOrderRepo{
+		db: db,
+	}
+}
+
+// purchaseOrderStatusColumns are the columns keeping when an order entered
+// each status.
+var purchaseOrderStatusColumns = map[organization_service.PurchaseOrderStatus]string{
+	organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_SUBMITTED: "submitted_at",
+	organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_CONFIRMED: "confirmed_at",
+	organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_RECEIVED:  "received_at",
+	organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_CANCELLED: "cancelled_at",
+}
+
+const purchaseOrderColumns = `
+			id,
+			order_number,
+			magazin_id,
+			provider_id,
+			status,
+			TO_CHAR(expected_delivery_date, 'YYYY-MM-DD'),
+			comment,
+			total_amount::float8,
+			created_by,
+			submitted_at,
+			confirmed_at,
+			received_at,
+			cancelled_at,
+			created_at,
+			updated_at`
+
+// Create adds a draft order with its items.
+func (c *purchaseOrderRepo) Create(ctx context.Context, req *models.PurchaseOrderCreate) (resp *organization_service.PurchaseOrderPK, err error) {
+	ctx, end := track(ctx, "purchase_order", "Create")
+	defer end()
+
+	id := uuid.New().String()
+
+	err = c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO "purchase_order" (
+				id,
+				order_number,
+				magazin_id,
+				provider_id,
+				status,
+				expected_delivery_date,
+				comment,
+				created_by,
+				created_at,
+				updated_at
+			) VALUES ($1, $2, $3, $4, $5, $6::date, $7, $8, NOW(), NOW())
+		`,
+			id,
+			req.OrderNumber,
+			req.MagazinId,
+			req.ProviderId,
+			int32(organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_DRAFT),
+			helper.NewNullString(req.ExpectedDeliveryDate),
+			helper.NewNullString(req.Comment),
+			helper.NewNullString(req.CreatedBy),
+		)
+		if err != nil {
+			return err
+		}
+
+		return replacePurchaseOrderItems(ctx, tx, id, req.Items)
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return &organization_service.PurchaseOrderPK{Id: id}, nil
+}
+
+// GetByID returns the order with its items.
+func (c *purchaseOrderRepo) GetByID(ctx context.Context, req *organization_service.PurchaseOrderPK) (*organization_service.PurchaseOrder, error) {
+	ctx, end := track(ctx, "purchase_order", "GetByID")
+	defer end()
+
+	query := `SELECT ` + purchaseOrderColumns + `
+		FROM "purchase_order"
+		WHERE id = $1
+	`
+
+	order, err := scanPurchaseOrder(c.db.QueryRow(ctx, query, req.Id))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	rows, err := c.db.Query(ctx, `
+		SELECT
+			id,
+			product_id,
+			quantity,
+			unit_price::float8,
+			total::float8
+		FROM "purchase_order_item"
+		WHERE purchase_order_id = $1
+		ORDER BY total DESC, product_id
+	`, req.Id)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullString
+			product_id sql.NullString
+			quantity   sql.NullInt32
+			unit_price sql.NullFloat64
+			total      sql.NullFloat64
+		)
+
+		err := rows.Scan(
+			&id,
+			&product_id,
+			&quantity,
+			&unit_price,
+			&total,
+		)
+		if err != nil {
+			return nil, dbError(err)
+		}
+
+		order.Items = append(order.Items, &organization_service.PurchaseOrderItem{
+			Id:        id.String,
+			ProductId: product_id.String,
+			Quantity:  quantity.Int32,
+			UnitPrice: unit_price.Float64,
+			Total:     total.Float64,
+		})
+	}
+
+	return order, dbError(rows.Err())
+}
+
+// GetList returns the orders without their items, the newest first.
+func (c *purchaseOrderRepo) GetList(ctx context.Context, req *organization_service.GetListPurchaseOrderRequest) (resp *organization_service.GetListPurchaseOrderResponse, err error) {
+	ctx, end := track(ctx, "purchase_order", "GetList")
+	defer end()
+
+	resp = &organization_service.GetListPurchaseOrderResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY created_at DESC"
+	)
+
+	query = `SELECT COUNT(*) OVER(),` + purchaseOrderColumns + `
+		FROM "purchase_order"
+	`
+	if req.MagazinId != "" {
+		filter += " AND magazin_id = :magazin_id "
+		params["magazin_id"] = req.MagazinId
+	}
+	if req.ProviderId != "" {
+		filter += " AND provider_id = :provider_id "
+		params["provider_id"] = req.ProviderId
+	}
+	if len(req.Statuses) > 0 {
+		statuses := make([]int32, 0, len(req.Statuses))
+		for _, status := range req.Statuses {
+			statuses = append(statuses, int32(status))
+		}
+
+		filter += " AND status = ANY(:statuses) "
+		params["statuses"] = statuses
+	}
+	if req.Limit > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.Offset > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		order, err := scanPurchaseOrder(rows, &resp.Count)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.PurchaseOrders = append(resp.PurchaseOrders, order)
+	}
+
+	return resp, dbError(rows.Err())
+}
+
+// UpdateDraft replaces the terms and the items of the order if it is still a
+// draft.
+func (c *purchaseOrderRepo) UpdateDraft(ctx context.Context, req *organization_service.UpdatePurchaseOrder) (resp int64, err error) {
+	ctx, end := track(ctx, "purchase_order", "UpdateDraft")
+	defer end()
+
+	err = c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, `
+			UPDATE
+				"purchase_order"
+			SET
+				expected_delivery_date = $3::date,
+				comment = $4
+			WHERE id = $1 AND status = $2
+		`,
+			req.Id,
+			int32(organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_DRAFT),
+			helper.NewNullString(req.ExpectedDeliveryDate),
+			helper.NewNullString(req.Comment),
+		)
+		if err != nil {
+			return err
+		}
+
+		resp = result.RowsAffected()
+		if resp == 0 {
+			return nil
+		}
+
+		if _, err = tx.Exec(ctx, `DELETE FROM "purchase_order_item" WHERE purchase_order_id = $1`, req.Id); err != nil {
+			return err
+		}
+
+		return replacePurchaseOrderItems(ctx, tx, req.Id, req.Items)
+	})
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return resp, nil
+}
+
+// ChangeStatus moves the order to req.To if it is still in req.From, and
+// records when it happened.
+func (c *purchaseOrderRepo) ChangeStatus(ctx context.Context, req *models.PurchaseOrderStatusChange) (int64, error) {
+	ctx, end := track(ctx, "purchase_order", "ChangeStatus")
+	defer end()
+
+	column, ok := purchaseOrderStatusColumns[req.To]
+	if !ok {
+		return 0, fmt.Errorf("purchase order can't be moved to %s", req.To)
+	}
+
+	query := fmt.Sprintf(`UPDATE "purchase_order" SET status = $2, %s = NOW() WHERE id = $1 AND status = $3`, column)
+
+	result, err := c.db.Exec(ctx, query, req.Id, int32(req.To), int32(req.From))
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// DeleteDraft deletes the order if it is still a draft.
+func (c *purchaseOrderRepo) DeleteDraft(ctx context.Context, req *organization_service.PurchaseOrderPK) (int64, error) {
+	ctx, end := track(ctx, "purchase_order", "DeleteDraft")
+	defer end()
+
+	query := `DELETE FROM "purchase_order" WHERE id = $1 AND status = $2`
+
+	result, err := c.db.Exec(ctx, query, req.Id, int32(organization_service.PurchaseOrderStatus_PURCHASE_ORDER_STATUS_DRAFT))
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// replacePurchaseOrderItems inserts the items of the order and recomputes its
+// total. The previous items must already be deleted.
+func replacePurchaseOrderItems(ctx context.Context, tx pgx.Tx, orderID string, items []*organization_service.PurchaseOrderItemInput) error {
+	for _, item := range items {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO "purchase_order_item" (
+				id,
+				purchase_order_id,
+				product_id,
+				quantity,
+				unit_price
+			) VALUES ($1, $2, $3, $4, $5)
+		`,
+			uuid.New().String(),
+			orderID,
+			item.ProductId,
+			item.Quantity,
+			item.UnitPrice,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.Exec(ctx, `
+		UPDATE "purchase_order" SET total_amount = (
+			SELECT COALESCE(SUM(total), 0) FROM "purchase_order_item" WHERE purchase_order_id = $1
+		) WHERE id = $1
+	`, orderID)
+
+	return err
+}
+
+// scanPurchaseOrder scans purchaseOrderColumns, preceded by the columns in
+// dest if any.
+func scanPurchaseOrder(row pgx.Row, dest ...interface{}) (*organization_service.PurchaseOrder, error) {
+	var (
+		id                     sql.NullString
+		order_number           sql.NullString
+		magazin_id             sql.NullString
+		provider_id            sql.NullString
+		status                 sql.NullInt32
+		expected_delivery_date sql.NullString
+		comment                sql.NullString
+		total_amount           sql.NullFloat64
+		created_by             sql.NullString
+		submitted_at           sql.NullString
+		confirmed_at           sql.NullString
+		received_at            sql.NullString
+		cancelled_at           sql.NullString
+		created_at             sql.NullString
+		updated_at             sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&order_number,
+		&magazin_id,
+		&provider_id,
+		&status,
+		&expected_delivery_date,
+		&comment,
+		&total_amount,
+		&created_by,
+		&submitted_at,
+		&confirmed_at,
+		&received_at,
+		&cancelled_at,
+		&created_at,
+		&updated_at,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization_service.PurchaseOrder{
+		Id:                   id.String,
+		OrderNumber:          order_number.String,
+		MagazinId:            magazin_id.String,
+		ProviderId:           provider_id.String,
+		Status:               organization_service.PurchaseOrderStatus(status.Int32),
+		ExpectedDeliveryDate: expected_delivery_date.String,
+		Comment:              comment.String,
+		TotalAmount:          total_amount.Float64,
+		CreatedBy:            created_by.String,
+		SubmittedAt:          submitted_at.String,
+		ConfirmedAt:          confirmed_at.String,
+		ReceivedAt:           received_at.String,
+		CancelledAt:          cancelled_at.String,
+		CreatedAt:            created_at.String,
+		UpdatedAt:            updated_at.String,
+	}, nil
+}
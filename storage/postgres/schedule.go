@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"organization_service/genproto/organization_service"
+	"organization_service/pkg/helper"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type scheduleRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewScheduleRepo(db *pgxpool.Pool) *scheduleRepo {
+	return &scheduleRepo{
+		db: db,
+	}
+}
+
+// ReplaceWeeklyHours replaces the weekly hours of the filial or the magazin
+// of req.
+func (c *scheduleRepo) ReplaceWeeklyHours(ctx context.Context, req *organization_service.SetWeeklyHoursRequest) error {
+	ctx, end := track(ctx, "working_hours", "ReplaceWeeklyHours")
+	defer end()
+
+	err := c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			DELETE FROM "working_hours"
+			WHERE filial_id IS NOT DISTINCT FROM $1 AND magazin_id IS NOT DISTINCT FROM $2
+		`,
+			helper.NewNullString(req.FilialId),
+			helper.NewNullString(req.MagazinId),
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, hours := range req.Hours {
+			_, err = tx.Exec(ctx, `
+				INSERT INTO "working_hours" (
+					id,
+					filial_id,
+					magazin_id,
+					weekday,
+					opens_at,
+					closes_at
+				) VALUES ($1, $2, $3, $4, $5::time, $6::time)
+			`,
+				uuid.New().String(),
+				helper.NewNullString(req.FilialId),
+				helper.NewNullString(req.MagazinId),
+				hours.Weekday,
+				hours.OpensAt,
+				hours.ClosesAt,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return dbError(err)
+	}
+
+	return nil
+}
+
+// GetWeeklyHours returns the weekly hours of the filial or the magazin of
+// req, by weekday and time.
+func (c *scheduleRepo) GetWeeklyHours(ctx context.Context, req *organization_service.GetWeeklyHoursRequest) ([]*organization_service.WorkingHours, error) {
+	ctx, end := track(ctx, "working_hours", "GetWeeklyHours")
+	defer end()
+
+	rows, err := c.db.Query(ctx, `
+		SELECT
+			weekday,
+			TO_CHAR(opens_at, 'HH24:MI'),
+			TO_CHAR(closes_at, 'HH24:MI')
+		FROM "working_hours"
+		WHERE filial_id IS NOT DISTINCT FROM $1 AND magazin_id IS NOT DISTINCT FROM $2
+		ORDER BY weekday, opens_at
+	`,
+		helper.NewNullString(req.FilialId),
+		helper.NewNullString(req.MagazinId),
+	)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	defer rows.Close()
+
+	var resp []*organization_service.WorkingHours
+	for rows.Next() {
+		var hours organization_service.WorkingHours
+
+		if err = rows.Scan(&hours.Weekday, &hours.OpensAt, &hours.ClosesAt); err != nil {
+			return nil, dbError(err)
+		}
+
+		resp = append(resp, &hours)
+	}
+
+	return resp, dbError(rows.Err())
+}
+
+// UpsertException sets the exception of the date of req, replacing the
+// previous one of the same filial or magazin and date.
+func (c *scheduleRepo) UpsertException(ctx context.Context, req *organization_service.SetScheduleExceptionRequest) (*organization_service.ScheduleException, error) {
+	ctx, end := track(ctx, "schedule_exception", "UpsertException")
+	defer end()
+
+	conflict := `(filial_id, date) WHERE filial_id IS NOT NULL`
+	if req.MagazinId != "" {
+		conflict = `(magazin_id, date) WHERE magazin_id IS NOT NULL`
+	}
+
+	query := `
+		INSERT INTO "schedule_exception" (
+			id,
+			filial_id,
+			magazin_id,
+			date,
+			opens_at,
+			closes_at,
+			reason,
+			created_at
+		) VALUES ($1, $2, $3, $4::date, $5::time, $6::time, $7, NOW())
+		ON CONFLICT ` + conflict + ` DO UPDATE SET
+			opens_at = EXCLUDED.opens_at,
+			closes_at = EXCLUDED.closes_at,
+			reason = EXCLUDED.reason
+		RETURNING ` + scheduleExceptionColumns
+
+	exception, err := scanScheduleException(c.db.QueryRow(
+		ctx,
+		query,
+		uuid.New().String(),
+		helper.NewNullString(req.FilialId),
+		helper.NewNullString(req.MagazinId),
+		req.Date,
+		helper.NewNullString(req.OpensAt),
+		helper.NewNullString(req.ClosesAt),
+		helper.NewNullString(req.Reason),
+	))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return exception, nil
+}
+
+// DeleteException deletes the exception and returns it.
+func (c *scheduleRepo) DeleteException(ctx context.Context, req *organization_service.ScheduleExceptionPK) (*organization_service.ScheduleException, error) {
+	ctx, end := track(ctx, "schedule_exception", "DeleteException")
+	defer end()
+
+	query := `DELETE FROM "schedule_exception" WHERE id = $1 RETURNING ` + scheduleExceptionColumns
+
+	exception, err := scanScheduleException(c.db.QueryRow(ctx, query, req.Id))
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return exception, nil
+}
+
+// GetExceptionList returns the exceptions of the filial or the magazin of
+// req between its dates, by date.
+func (c *scheduleRepo) GetExceptionList(ctx context.Context, req *organization_service.ListScheduleExceptionsRequest) (resp *organization_service.ListScheduleExceptionsResponse, err error) {
+	ctx, end := track(ctx, "schedule_exception", "GetExceptionList")
+	defer end()
+
+	resp = &organization_service.ListScheduleExceptionsResponse{}
+
+	var (
+		query  string
+		params = map[string]interface{}{
+			"filial_id":  helper.NewNullString(req.FilialId),
+			"magazin_id": helper.NewNullString(req.MagazinId),
+		}
+		filter = " WHERE filial_id IS NOT DISTINCT FROM :filial_id AND magazin_id IS NOT DISTINCT FROM :magazin_id "
+		sort   = " ORDER BY date"
+	)
+
+	query = `SELECT COUNT(*) OVER(),` + scheduleExceptionColumns + `
+		FROM "schedule_exception"
+	`
+	if req.DateFrom != "" {
+		filter += " AND date >= :date_from::date "
+		params["date_from"] = req.DateFrom
+	}
+	if req.DateTo != "" {
+		filter += " AND date <= :date_to::date "
+		params["date_to"] = req.DateTo
+	}
+	query += filter + sort
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		exception, err := scanScheduleException(rows, &resp.Count)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Exceptions = append(resp.Exceptions, exception)
+	}
+
+	return resp, dbError(rows.Err())
+}
+
+const scheduleExceptionColumns = `
+			id,
+			filial_id,
+			magazin_id,
+			TO_CHAR(date, 'YYYY-MM-DD'),
+			TO_CHAR(opens_at, 'HH24:MI'),
+			TO_CHAR(closes_at, 'HH24:MI'),
+			reason,
+			created_at`
+
+// scanScheduleException scans scheduleExceptionColumns, preceded by the
+// columns in dest if any.
+func scanScheduleException(row pgx.Row, dest ...interface{}) (*organization_service.ScheduleException, error) {
+	var (
+		id         sql.NullString
+		filial_id  sql.NullString
+		magazin_id sql.NullString
+		date       sql.NullString
+		opens_at   sql.NullString
+		closes_at  sql.NullString
+		reason     sql.NullString
+		created_at sql.NullString
+	)
+
+	err := row.Scan(append(dest,
+		&id,
+		&filial_id,
+		&magazin_id,
+		&date,
+		&opens_at,
+		&closes_at,
+		&reason,
+		&created_at,
+	)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organization_service.ScheduleException{
+		Id:        id.String,
+		FilialId:  filial_id.String,
+		MagazinId: magazin_id.String,
+		Date:      date.String,
+		Closed:    !opens_at.Valid,
+		OpensAt:   opens_at.String,
+		ClosesAt:  closes_at.String,
+		Reason:    reason.String,
+		CreatedAt: created_at.String,
+	}, nil
+}
@@ -8,8 +8,10 @@ import (
 	"organization_service/genproto/organization_service"
 	"organization_service/models"
 	"organization_service/pkg/helper"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -24,6 +26,9 @@ func NewStaffRepo(db *pgxpool.Pool) *staffRepo {
 }
 
 func (c *staffRepo) Create(ctx context.Context, req *organization_service.CreateStaff) (resp *organization_service.StaffPK, err error) {
+	ctx, end := track(ctx, "staff", "Create")
+	defer end()
+
 	id := uuid.New().String()
 
 	query := `
@@ -36,9 +41,10 @@ func (c *staffRepo) Create(ctx context.Context, req *organization_service.Create
 			password,
 			staff_type,
 			magazin_id,
+			status,
 			created_at,
 			updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
 	`
 
 	_, err = c.db.Exec(
@@ -52,16 +58,20 @@ func (c *staffRepo) Create(ctx context.Context, req *organization_service.Create
 		req.Password,
 		req.StaffType,
 		req.MagazinId,
+		models.StaffInvited,
 	)
 	if err != nil {
 		fmt.Println(err)
-		return nil, err
+		return nil, dbError(err)
 	}
 
 	return &organization_service.StaffPK{Id: id}, nil
 }
 
 func (c *staffRepo) GetByID(ctx context.Context, req *organization_service.StaffPK) (staff *organization_service.Staff, err error) {
+	ctx, end := track(ctx, "staff", "GetByID")
+	defer end()
+
 	query := `
 			SELECT 
 		    s.id,
@@ -69,11 +79,14 @@ func (c *staffRepo) GetByID(ctx context.Context, req *organization_service.Staff
 			s.last_name,
 			s.phone,
 			s.login,
-			s.password,
 			s.staff_type,
 		    m.id,
 		    s.created_at,
-		    s.updated_at
+		    s.updated_at,
+			s.status,
+			s.status_reason,
+			TO_CHAR(s.status_effective_at, 'YYYY-MM-DD HH24:MI:SS'),
+			TO_CHAR(s.locked_until, 'YYYY-MM-DD HH24:MI:SS')
 		FROM "staff" AS s
 		JOIN "magazin" AS m ON m.id = s.magazin_id
 		WHERE s.id = $1;
@@ -84,11 +97,15 @@ func (c *staffRepo) GetByID(ctx context.Context, req *organization_service.Staff
 		last_name  sql.NullString
 		phone      sql.NullString
 		login      sql.NullString
-		password   sql.NullString
 		staff_type sql.NullString
 		magazin_id sql.NullString
 		created_at sql.NullString
 		updated_at sql.NullString
+
+		status              sql.NullString
+		status_reason       sql.NullString
+		status_effective_at sql.NullString
+		locked_until        sql.NullString
 	)
 
 	err = c.db.QueryRow(ctx, query, req.Id).Scan(
@@ -97,14 +114,17 @@ func (c *staffRepo) GetByID(ctx context.Context, req *organization_service.Staff
 		&last_name,
 		&phone,
 		&login,
-		&password,
 		&staff_type,
 		&magazin_id,
 		&created_at,
 		&updated_at,
+		&status,
+		&status_reason,
+		&status_effective_at,
+		&locked_until,
 	)
 	if err != nil {
-		return staff, err
+		return staff, dbError(err)
 	}
 
 	staff = &organization_service.Staff{
@@ -113,17 +133,24 @@ func (c *staffRepo) GetByID(ctx context.Context, req *organization_service.Staff
 		LastName:  last_name.String,
 		Phone:     phone.String,
 		Login:     login.String,
-		Password:  password.String,
 		StaffType: staff_type.String,
 		MagazinId: magazin_id.String,
 		CreatedAt: created_at.String,
 		UpdatedAt: updated_at.String,
+
+		Status:            status.String,
+		StatusReason:      status_reason.String,
+		StatusEffectiveAt: status_effective_at.String,
+		LockedUntil:       locked_until.String,
 	}
 
 	return
 }
 
 func (c *staffRepo) GetList(ctx context.Context, req *organization_service.GetListStaffRequest) (resp *organization_service.GetListStaffResponse, err error) {
+	ctx, end := track(ctx, "staff", "GetList")
+	defer end()
+
 	resp = &organization_service.GetListStaffResponse{}
 
 	var (
@@ -132,7 +159,7 @@ func (c *staffRepo) GetList(ctx context.Context, req *organization_service.GetLi
 		offset = " OFFSET 0 "
 		params = make(map[string]interface{})
 		filter = " WHERE TRUE "
-		sort   = " ORDER BY created_at DESC"
+		sort   = " ORDER BY s.created_at DESC"
 	)
 
 	query = `
@@ -143,16 +170,19 @@ func (c *staffRepo) GetList(ctx context.Context, req *organization_service.GetLi
 			s.last_name,
 			s.phone,
 			s.login,
-			s.password,
 			s.staff_type,
 			m.id,
 			s.created_at,
-			s.updated_at
-		FROM "staff" AS m
-		JOIN filial AS f ON f.id = m.filial_id
+			s.updated_at,
+			s.status,
+			s.status_reason,
+			TO_CHAR(s.status_effective_at, 'YYYY-MM-DD HH24:MI:SS'),
+			TO_CHAR(s.locked_until, 'YYYY-MM-DD HH24:MI:SS')
+		FROM "staff" AS s
+		JOIN "magazin" AS m ON m.id = s.magazin_id
 	`
 	if len(req.GetSearch()) > 0 {
-		filter += " AND first_name ILIKE '%' || '" + req.Search + "' || '%' "
+		filter += " AND s.first_name ILIKE '%' || '" + req.Search + "' || '%' "
 	}
 	if req.GetLimit() > 0 {
 		limit = " LIMIT :limit"
@@ -168,7 +198,7 @@ func (c *staffRepo) GetList(ctx context.Context, req *organization_service.GetLi
 
 	rows, err := c.db.Query(ctx, query, args...)
 	if err != nil {
-		return resp, err
+		return resp, dbError(err)
 	}
 	defer rows.Close()
 
@@ -179,11 +209,15 @@ func (c *staffRepo) GetList(ctx context.Context, req *organization_service.GetLi
 			last_name  sql.NullString
 			phone      sql.NullString
 			login      sql.NullString
-			password   sql.NullString
 			staff_type sql.NullString
 			magazin_id sql.NullString
 			created_at sql.NullString
 			updated_at sql.NullString
+
+			status              sql.NullString
+			status_reason       sql.NullString
+			status_effective_at sql.NullString
+			locked_until        sql.NullString
 		)
 
 		err := rows.Scan(
@@ -193,14 +227,17 @@ func (c *staffRepo) GetList(ctx context.Context, req *organization_service.GetLi
 			&last_name,
 			&phone,
 			&login,
-			&password,
 			&staff_type,
 			&magazin_id,
 			&created_at,
 			&updated_at,
+			&status,
+			&status_reason,
+			&status_effective_at,
+			&locked_until,
 		)
 		if err != nil {
-			return resp, err
+			return resp, dbError(err)
 		}
 
 		resp.Staffs = append(resp.Staffs, &organization_service.Staff{
@@ -209,11 +246,15 @@ func (c *staffRepo) GetList(ctx context.Context, req *organization_service.GetLi
 			LastName:  last_name.String,
 			Phone:     phone.String,
 			Login:     login.String,
-			Password:  password.String,
 			StaffType: staff_type.String,
 			MagazinId: magazin_id.String,
 			CreatedAt: created_at.String,
 			UpdatedAt: updated_at.String,
+
+			Status:            status.String,
+			StatusReason:      status_reason.String,
+			StatusEffectiveAt: status_effective_at.String,
+			LockedUntil:       locked_until.String,
 		})
 	}
 
@@ -221,6 +262,9 @@ func (c *staffRepo) GetList(ctx context.Context, req *organization_service.GetLi
 }
 
 func (c *staffRepo) Update(ctx context.Context, req *organization_service.UpdateStaff) (resp int64, err error) {
+	ctx, end := track(ctx, "staff", "Update")
+	defer end()
+
 	var (
 		query  string
 		params map[string]interface{}
@@ -234,19 +278,19 @@ func (c *staffRepo) Update(ctx context.Context, req *organization_service.Update
 			last_name= :last_name,
 			phone = :phone,
 			login = :login,
-			password = : password,
+			password = :password,
 			staff_type = :staff_type,
 			magazin_id = :magazin_id,
 			updated_at = now()
 		WHERE id = :id
 	`
 	params = map[string]interface{}{
-		"id":        req.GetId(),
-		"first_name":      req.GetFirstName(),
-		"last_name": req.GetLastName(),
-		"phone": req.GetPhone(),
-		"login": req.GetLogin(),
-		"password": req.GetPassword(),
+		"id":         req.GetId(),
+		"first_name": req.GetFirstName(),
+		"last_name":  req.GetLastName(),
+		"phone":      req.GetPhone(),
+		"login":      req.GetLogin(),
+		"password":   req.GetPassword(),
 		"staff_type": req.GetStaffType(),
 		"magazin_id": req.GetMagazinId(),
 	}
@@ -255,6 +299,7 @@ func (c *staffRepo) Update(ctx context.Context, req *organization_service.Update
 
 	result, err := c.db.Exec(ctx, query, args...)
 	if err != nil {
+		err = dbError(err)
 		return
 	}
 
@@ -262,6 +307,9 @@ func (c *staffRepo) Update(ctx context.Context, req *organization_service.Update
 }
 
 func (c *staffRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error) {
+	ctx, end := track(ctx, "staff", "UpdatePatch")
+	defer end()
+
 	var (
 		set   = " SET "
 		ind   = 0
@@ -295,6 +343,7 @@ func (c *staffRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequ
 
 	result, err := c.db.Exec(ctx, query, args...)
 	if err != nil {
+		err = dbError(err)
 		return
 	}
 
@@ -302,12 +351,256 @@ func (c *staffRepo) UpdatePatch(ctx context.Context, req *models.UpdatePatchRequ
 }
 
 func (c *staffRepo) Delete(ctx context.Context, req *organization_service.StaffPK) error {
+	ctx, end := track(ctx, "staff", "Delete")
+	defer end()
+
 	query := `DELETE FROM "staff" WHERE id = $1`
 
 	_, err := c.db.Exec(ctx, query, req.Id)
 	if err != nil {
-		return err
+		return dbError(err)
 	}
 
 	return nil
 }
+
+// GetByPhone returns the most recently created staff with the given phone.
+func (c *staffRepo) GetByPhone(ctx context.Context, req *organization_service.StaffPhoneRequest) (*organization_service.Staff, error) {
+	ctx, end := track(ctx, "staff", "GetByPhone")
+	defer end()
+
+	query := `SELECT id FROM "staff" WHERE phone = $1 ORDER BY created_at DESC LIMIT 1`
+
+	var id string
+
+	err := c.db.QueryRow(ctx, query, req.Phone).Scan(&id)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return c.GetByID(ctx, &organization_service.StaffPK{Id: id})
+}
+
+// ChangeStatus applies the status change and records it in the history. It
+// affects no rows if the staff is no longer in status req.From.
+func (c *staffRepo) ChangeStatus(ctx context.Context, req *models.StaffStatusChange) (resp int64, err error) {
+	ctx, end := track(ctx, "staff", "ChangeStatus")
+	defer end()
+
+	err = c.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, `
+			UPDATE
+				"staff"
+			SET
+				status = $2,
+				previous_status = status,
+				status_reason = $3,
+				status_effective_at = $4
+			WHERE id = $1 AND status = $5
+		`,
+			req.StaffId,
+			req.To,
+			req.Reason,
+			req.EffectiveAt,
+			req.From,
+		)
+		if err != nil {
+			return err
+		}
+
+		resp = result.RowsAffected()
+		if resp == 0 {
+			return nil
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO "staff_status_history" (
+				id,
+				staff_id,
+				from_status,
+				to_status,
+				reason,
+				effective_at,
+				created_at
+			) VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		`,
+			uuid.New().String(),
+			req.StaffId,
+			req.From,
+			req.To,
+			req.Reason,
+			req.EffectiveAt,
+		)
+
+		return err
+	})
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return resp, nil
+}
+
+// GetAuthByLogin returns the credentials and status of the staff with login.
+func (c *staffRepo) GetAuthByLogin(ctx context.Context, login string) (*models.StaffAuth, error) {
+	ctx, end := track(ctx, "staff", "GetAuthByLogin")
+	defer end()
+
+	return c.getAuth(ctx, `WHERE login = $1`, login)
+}
+
+// GetAuthByID returns the credentials and status of the staff with id.
+func (c *staffRepo) GetAuthByID(ctx context.Context, id string) (*models.StaffAuth, error) {
+	ctx, end := track(ctx, "staff", "GetAuthByID")
+	defer end()
+
+	return c.getAuth(ctx, `WHERE id = $1`, id)
+}
+
+// GetAuthByPhone returns the credentials and status of the most recently
+// created staff with phone.
+func (c *staffRepo) GetAuthByPhone(ctx context.Context, phone string) (*models.StaffAuth, error) {
+	ctx, end := track(ctx, "staff", "GetAuthByPhone")
+	defer end()
+
+	return c.getAuth(ctx, `WHERE phone = $1 ORDER BY created_at DESC LIMIT 1`, phone)
+}
+
+func (c *staffRepo) getAuth(ctx context.Context, where string, arg string) (*models.StaffAuth, error) {
+	query := `
+		SELECT
+			id,
+			magazin_id,
+			staff_type,
+			phone,
+			password,
+			status,
+			previous_status,
+			status_effective_at,
+			failed_logins,
+			lockouts,
+			locked_until
+		FROM "staff"
+	` + where
+
+	var (
+		id                  sql.NullString
+		magazin_id          sql.NullString
+		staff_type          sql.NullString
+		phone               sql.NullString
+		password            sql.NullString
+		status              sql.NullString
+		previous_status     sql.NullString
+		status_effective_at sql.NullTime
+		failed_logins       sql.NullInt32
+		lockouts            sql.NullInt32
+		locked_until        sql.NullTime
+	)
+
+	err := c.db.QueryRow(ctx, query, arg).Scan(
+		&id,
+		&magazin_id,
+		&staff_type,
+		&phone,
+		&password,
+		&status,
+		&previous_status,
+		&status_effective_at,
+		&failed_logins,
+		&lockouts,
+		&locked_until,
+	)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return &models.StaffAuth{
+		Id:                id.String,
+		MagazinId:         magazin_id.String,
+		StaffType:         staff_type.String,
+		Phone:             phone.String,
+		Password:          password.String,
+		Status:            status.String,
+		PreviousStatus:    previous_status.String,
+		StatusEffectiveAt: status_effective_at.Time,
+		FailedLogins:      int(failed_logins.Int32),
+		Lockouts:          int(lockouts.Int32),
+		LockedUntil:       locked_until.Time,
+	}, nil
+}
+
+// UpdatePassword stores a new password hash of the staff.
+func (c *staffRepo) UpdatePassword(ctx context.Context, id, password string) (int64, error) {
+	ctx, end := track(ctx, "staff", "UpdatePassword")
+	defer end()
+
+	result, err := c.db.Exec(ctx, `UPDATE "staff" SET password = $2 WHERE id = $1`, id, password)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// RegisterLoginFailure counts a failed login of the staff and returns the
+// failed logins in a row and the lockouts so far.
+func (c *staffRepo) RegisterLoginFailure(ctx context.Context, id string) (failedLogins, lockouts int, err error) {
+	ctx, end := track(ctx, "staff", "RegisterLoginFailure")
+	defer end()
+
+	query := `UPDATE "staff" SET failed_logins = failed_logins + 1 WHERE id = $1 RETURNING failed_logins, lockouts`
+
+	err = c.db.QueryRow(ctx, query, id).Scan(&failedLogins, &lockouts)
+	if err != nil {
+		return 0, 0, dbError(err)
+	}
+
+	return failedLogins, lockouts, nil
+}
+
+// Lock locks the staff out until the given time and starts counting failed
+// logins anew.
+func (c *staffRepo) Lock(ctx context.Context, id string, until time.Time) (int64, error) {
+	ctx, end := track(ctx, "staff", "Lock")
+	defer end()
+
+	query := `
+		UPDATE
+			"staff"
+		SET
+			locked_until = $2,
+			lockouts = lockouts + 1,
+			failed_logins = 0
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, id, until)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// Unlock lifts a lockout and forgets the failed logins of the staff.
+func (c *staffRepo) Unlock(ctx context.Context, id string) (int64, error) {
+	ctx, end := track(ctx, "staff", "Unlock")
+	defer end()
+
+	query := `
+		UPDATE
+			"staff"
+		SET
+			locked_until = NULL,
+			lockouts = 0,
+			failed_logins = 0
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, id)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,617 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"organization_service/genproto/organization_service"
+	"organization_service/models"
+	"organization_service/pkg/helper"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type webhookRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewWebhookRepo(db *pgxpool.Pool) *webhookRepo {
+	return &webhookRepo{
+		db: db,
+	}
+}
+
+func (c *webhookRepo) Create(ctx context.Context, req *organization_service.CreateWebhook) (resp *organization_service.WebhookPK, err error) {
+	ctx, end := track(ctx, "webhook", "Create")
+	defer end()
+
+	id := uuid.New().String()
+
+	query := `
+		INSERT INTO "webhook" (
+			id,
+			filial_id,
+			event_type,
+			url,
+			secret,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+	`
+
+	_, err = c.db.Exec(
+		ctx,
+		query,
+		id,
+		helper.NewNullString(req.FilialId),
+		req.EventType,
+		req.Url,
+		req.Secret,
+	)
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	return &organization_service.WebhookPK{Id: id}, nil
+}
+
+func (c *webhookRepo) GetByID(ctx context.Context, req *organization_service.WebhookPK) (webhook *organization_service.Webhook, err error) {
+	ctx, end := track(ctx, "webhook", "GetByID")
+	defer end()
+
+	query := `
+		SELECT
+			id,
+			filial_id,
+			event_type,
+			url,
+			is_active,
+			created_at,
+			updated_at
+		FROM "webhook"
+		WHERE id = $1
+	`
+
+	var (
+		id         sql.NullString
+		filial_id  sql.NullString
+		event_type sql.NullString
+		url        sql.NullString
+		is_active  sql.NullBool
+		created_at sql.NullString
+		updated_at sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&filial_id,
+		&event_type,
+		&url,
+		&is_active,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return webhook, dbError(err)
+	}
+
+	webhook = &organization_service.Webhook{
+		Id:        id.String,
+		FilialId:  filial_id.String,
+		EventType: event_type.String,
+		Url:       url.String,
+		IsActive:  is_active.Bool,
+		CreatedAt: created_at.String,
+		UpdatedAt: updated_at.String,
+	}
+
+	return
+}
+
+func (c *webhookRepo) GetList(ctx context.Context, req *organization_service.GetListWebhookRequest) (resp *organization_service.GetListWebhookResponse, err error) {
+	ctx, end := track(ctx, "webhook", "GetList")
+	defer end()
+
+	resp = &organization_service.GetListWebhookResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY created_at DESC"
+	)
+
+	query = `
+	SELECT
+		COUNT(*) OVER(),
+		id,
+		filial_id,
+		event_type,
+		url,
+		is_active,
+		TO_CHAR(created_at, 'YYYY-MM-DD HH24:MI:SS'),
+		TO_CHAR(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+	FROM "webhook"
+	`
+	if len(req.GetFilialId()) > 0 {
+		filter += " AND filial_id = :filial_id "
+		params["filial_id"] = req.FilialId
+	}
+	if len(req.GetEventType()) > 0 {
+		filter += " AND event_type = :event_type "
+		params["event_type"] = req.EventType
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         sql.NullString
+			filial_id  sql.NullString
+			event_type sql.NullString
+			url        sql.NullString
+			is_active  sql.NullBool
+			created_at sql.NullString
+			updated_at sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&filial_id,
+			&event_type,
+			&url,
+			&is_active,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Webhooks = append(resp.Webhooks, &organization_service.Webhook{
+			Id:        id.String,
+			FilialId:  filial_id.String,
+			EventType: event_type.String,
+			Url:       url.String,
+			IsActive:  is_active.Bool,
+			CreatedAt: created_at.String,
+			UpdatedAt: updated_at.String,
+		})
+	}
+
+	return
+}
+
+func (c *webhookRepo) Update(ctx context.Context, req *organization_service.UpdateWebhook) (resp int64, err error) {
+	ctx, end := track(ctx, "webhook", "Update")
+	defer end()
+
+	var (
+		query  string
+		params map[string]interface{}
+	)
+
+	query = `
+		UPDATE
+			"webhook"
+		SET
+			filial_id = :filial_id,
+			event_type = :event_type,
+			url = :url,
+			secret = COALESCE(NULLIF(:secret, ''), secret),
+			is_active = :is_active,
+			updated_at = now()
+		WHERE id = :id
+	`
+	params = map[string]interface{}{
+		"id":         req.GetId(),
+		"filial_id":  helper.NewNullString(req.GetFilialId()),
+		"event_type": req.GetEventType(),
+		"url":        req.GetUrl(),
+		"secret":     req.GetSecret(),
+		"is_active":  req.GetIsActive(),
+	}
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	result, err := c.db.Exec(ctx, query, args...)
+	if err != nil {
+		err = dbError(err)
+		return
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (c *webhookRepo) Delete(ctx context.Context, req *organization_service.WebhookPK) error {
+	ctx, end := track(ctx, "webhook", "Delete")
+	defer end()
+
+	query := `DELETE FROM "webhook" WHERE id = $1`
+
+	_, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return dbError(err)
+	}
+
+	return nil
+}
+
+// Enqueue stores one pending delivery of payload for every active webhook
+// subscribed to eventType. Webhooks without a filial receive events of all
+// filials. It returns the number of queued deliveries.
+func (c *webhookRepo) Enqueue(ctx context.Context, eventType, filialID string, payload []byte) (resp int64, err error) {
+	ctx, end := track(ctx, "webhook", "Enqueue")
+	defer end()
+
+	query := `
+		SELECT
+			id
+		FROM "webhook"
+		WHERE is_active
+			AND event_type IN ($1, '*')
+			AND (filial_id IS NULL OR filial_id = $2)
+	`
+
+	rows, err := c.db.Query(ctx, query, eventType, helper.NewNullString(filialID))
+	if err != nil {
+		return 0, err
+	}
+
+	var webhookIDs []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		webhookIDs = append(webhookIDs, id)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	insert := `
+		INSERT INTO "webhook_delivery" (
+			id,
+			webhook_id,
+			event_type,
+			payload,
+			created_at,
+			updated_at
+		) VALUES ($1, $2, $3, $4, NOW(), NOW())
+	`
+
+	for _, webhookID := range webhookIDs {
+		_, err = c.db.Exec(ctx, insert, uuid.New().String(), webhookID, eventType, payload)
+		if err != nil {
+			return resp, err
+		}
+		resp++
+	}
+
+	return resp, nil
+}
+
+// ClaimDueDeliveries returns up to limit pending deliveries whose next attempt
+// is due and pushes their next attempt forward by lease, so that concurrent
+// dispatchers don't pick up the same rows while they are being sent.
+func (c *webhookRepo) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) (resp []*models.WebhookDelivery, err error) {
+	ctx, end := track(ctx, "webhook", "ClaimDueDeliveries")
+	defer end()
+
+	query := `
+		UPDATE "webhook_delivery" AS d
+		SET
+			next_attempt_at = NOW() + $2 * INTERVAL '1 second'
+		FROM "webhook" AS w
+		WHERE w.id = d.webhook_id AND w.is_active AND d.id IN (
+			SELECT
+				id
+			FROM "webhook_delivery"
+			WHERE status = 'pending' AND next_attempt_at <= NOW()
+				AND EXISTS (SELECT 1 FROM "webhook" WHERE id = webhook_id AND is_active)
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			d.id,
+			d.webhook_id,
+			d.event_type,
+			d.payload,
+			d.attempts,
+			w.url,
+			w.secret
+	`
+
+	rows, err := c.db.Query(ctx, query, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		delivery := &models.WebhookDelivery{}
+
+		err = rows.Scan(
+			&delivery.Id,
+			&delivery.WebhookId,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Attempts,
+			&delivery.Url,
+			&delivery.Secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, delivery)
+	}
+
+	return resp, rows.Err()
+}
+
+func (c *webhookRepo) SaveAttempt(ctx context.Context, req *models.WebhookDeliveryAttempt) error {
+	ctx, end := track(ctx, "webhook", "SaveAttempt")
+	defer end()
+
+	query := `
+		UPDATE
+			"webhook_delivery"
+		SET
+			status = $2,
+			attempts = $3,
+			response_code = $4,
+			last_error = $5,
+			next_attempt_at = $6,
+			delivered_at = CASE WHEN $2 = 'delivered' THEN NOW() END,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := c.db.Exec(
+		ctx,
+		query,
+		req.Id,
+		req.Status,
+		req.Attempts,
+		sql.NullInt32{Int32: int32(req.ResponseCode), Valid: req.ResponseCode > 0},
+		helper.NewNullString(req.LastError),
+		req.NextAttemptAt,
+	)
+
+	return err
+}
+
+func (c *webhookRepo) GetDeliveryByID(ctx context.Context, req *organization_service.WebhookDeliveryPK) (delivery *organization_service.WebhookDelivery, err error) {
+	ctx, end := track(ctx, "webhook", "GetDeliveryByID")
+	defer end()
+
+	query := `
+		SELECT
+			id,
+			webhook_id,
+			event_type,
+			payload,
+			status,
+			attempts,
+			response_code,
+			last_error,
+			next_attempt_at,
+			delivered_at,
+			created_at,
+			updated_at
+		FROM "webhook_delivery"
+		WHERE id = $1
+	`
+
+	var (
+		id              sql.NullString
+		webhook_id      sql.NullString
+		event_type      sql.NullString
+		payload         sql.NullString
+		status          sql.NullString
+		attempts        sql.NullInt32
+		response_code   sql.NullInt32
+		last_error      sql.NullString
+		next_attempt_at sql.NullString
+		delivered_at    sql.NullString
+		created_at      sql.NullString
+		updated_at      sql.NullString
+	)
+
+	err = c.db.QueryRow(ctx, query, req.Id).Scan(
+		&id,
+		&webhook_id,
+		&event_type,
+		&payload,
+		&status,
+		&attempts,
+		&response_code,
+		&last_error,
+		&next_attempt_at,
+		&delivered_at,
+		&created_at,
+		&updated_at,
+	)
+	if err != nil {
+		return delivery, dbError(err)
+	}
+
+	delivery = &organization_service.WebhookDelivery{
+		Id:            id.String,
+		WebhookId:     webhook_id.String,
+		EventType:     event_type.String,
+		Payload:       payload.String,
+		Status:        status.String,
+		Attempts:      attempts.Int32,
+		ResponseCode:  response_code.Int32,
+		LastError:     last_error.String,
+		NextAttemptAt: next_attempt_at.String,
+		DeliveredAt:   delivered_at.String,
+		CreatedAt:     created_at.String,
+		UpdatedAt:     updated_at.String,
+	}
+
+	return
+}
+
+func (c *webhookRepo) GetDeliveryList(ctx context.Context, req *organization_service.GetListWebhookDeliveryRequest) (resp *organization_service.GetListWebhookDeliveryResponse, err error) {
+	ctx, end := track(ctx, "webhook", "GetDeliveryList")
+	defer end()
+
+	resp = &organization_service.GetListWebhookDeliveryResponse{}
+
+	var (
+		query  string
+		limit  = ""
+		offset = " OFFSET 0 "
+		params = make(map[string]interface{})
+		filter = " WHERE TRUE "
+		sort   = " ORDER BY created_at DESC"
+	)
+
+	query = `
+	SELECT
+		COUNT(*) OVER(),
+		id,
+		webhook_id,
+		event_type,
+		payload,
+		status,
+		attempts,
+		response_code,
+		last_error,
+		TO_CHAR(next_attempt_at, 'YYYY-MM-DD HH24:MI:SS'),
+		TO_CHAR(delivered_at, 'YYYY-MM-DD HH24:MI:SS'),
+		TO_CHAR(created_at, 'YYYY-MM-DD HH24:MI:SS'),
+		TO_CHAR(updated_at, 'YYYY-MM-DD HH24:MI:SS')
+	FROM "webhook_delivery"
+	`
+	if len(req.GetWebhookId()) > 0 {
+		filter += " AND webhook_id = :webhook_id "
+		params["webhook_id"] = req.WebhookId
+	}
+	if len(req.GetStatus()) > 0 {
+		filter += " AND status = :status "
+		params["status"] = req.Status
+	}
+	if req.GetLimit() > 0 {
+		limit = " LIMIT :limit"
+		params["limit"] = req.Limit
+	}
+	if req.GetOffset() > 0 {
+		offset = " OFFSET :offset"
+		params["offset"] = req.Offset
+	}
+	query += filter + sort + offset + limit
+
+	query, args := helper.ReplaceQueryParams(query, params)
+
+	rows, err := c.db.Query(ctx, query, args...)
+	if err != nil {
+		return resp, dbError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id              sql.NullString
+			webhook_id      sql.NullString
+			event_type      sql.NullString
+			payload         sql.NullString
+			status          sql.NullString
+			attempts        sql.NullInt32
+			response_code   sql.NullInt32
+			last_error      sql.NullString
+			next_attempt_at sql.NullString
+			delivered_at    sql.NullString
+			created_at      sql.NullString
+			updated_at      sql.NullString
+		)
+
+		err := rows.Scan(
+			&resp.Count,
+			&id,
+			&webhook_id,
+			&event_type,
+			&payload,
+			&status,
+			&attempts,
+			&response_code,
+			&last_error,
+			&next_attempt_at,
+			&delivered_at,
+			&created_at,
+			&updated_at,
+		)
+		if err != nil {
+			return resp, dbError(err)
+		}
+
+		resp.Deliveries = append(resp.Deliveries, &organization_service.WebhookDelivery{
+			Id:            id.String,
+			WebhookId:     webhook_id.String,
+			EventType:     event_type.String,
+			Payload:       payload.String,
+			Status:        status.String,
+			Attempts:      attempts.Int32,
+			ResponseCode:  response_code.Int32,
+			LastError:     last_error.String,
+			NextAttemptAt: next_attempt_at.String,
+			DeliveredAt:   delivered_at.String,
+			CreatedAt:     created_at.String,
+			UpdatedAt:     updated_at.String,
+		})
+	}
+
+	return
+}
+
+// ReplayDelivery puts a delivery back into the queue with a fresh attempt
+// budget, regardless of whether it was delivered or dead-lettered.
+func (c *webhookRepo) ReplayDelivery(ctx context.Context, req *organization_service.WebhookDeliveryPK) (int64, error) {
+	ctx, end := track(ctx, "webhook", "ReplayDelivery")
+	defer end()
+
+	query := `
+		UPDATE
+			"webhook_delivery"
+		SET
+			status = 'pending',
+			attempts = 0,
+			last_error = NULL,
+			next_attempt_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1
+	`
+
+	result, err := c.db.Exec(ctx, query, req.Id)
+	if err != nil {
+		return 0, dbError(err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -4,14 +4,25 @@ import (
 	"context"
 	"organization_service/genproto/organization_service"
 	"organization_service/models"
+	"time"
 )
 
 type StorageI interface {
 	CloseDB()
+	Ping(ctx context.Context) error
 	Filial() FilialRepoI
 	Magazin() MagazinRepoI
 	Staff() StaffRepoI
 	Provider() ProviderRepoI
+	Webhook() WebhookRepoI
+	PasswordReset() PasswordResetRepoI
+	LoginAttempt() LoginAttemptRepoI
+	ProviderContact() ProviderContactRepoI
+	ProviderMagazin() ProviderMagazinRepoI
+	ProviderProduct() ProviderProductRepoI
+	ProviderPerformance() ProviderPerformanceRepoI
+	PurchaseOrder() PurchaseOrderRepoI
+	Schedule() ScheduleRepoI
 }
 
 type FilialRepoI interface {
@@ -21,6 +32,9 @@ type FilialRepoI interface {
 	Update(context.Context, *organization_service.UpdateFilial) (int64, error)
 	UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error)
 	Delete(context.Context, *organization_service.FilialPK) error
+	NextCodeSequence(ctx context.Context, key string) (int64, error)
+	PeekCodeSequence(ctx context.Context, key string) (int64, error)
+	FindNearest(context.Context, *organization_service.FindNearestFilialsRequest) (*organization_service.FindNearestFilialsResponse, error)
 }
 
 type MagazinRepoI interface {
@@ -35,17 +49,108 @@ type MagazinRepoI interface {
 type ProviderRepoI interface {
 	Create(context.Context, *organization_service.CreateProvider) (*organization_service.ProviderPK, error)
 	GetByID(context.Context, *organization_service.ProviderPK) (*organization_service.Provider, error)
-	GetList(context.Context, *organization_service.GetListProviderRequest) (*organization_service.GetListProviderResponse, error)
+	GetByPhone(context.Context, *organization_service.ProviderPhoneRequest) (*organization_service.Provider, error)
+	GetList(ctx context.Context, req *organization_service.GetListProviderRequest, scoreSince time.Time) (*organization_service.GetListProviderResponse, error)
 	Update(context.Context, *organization_service.UpdateProvider) (int64, error)
 	UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error)
 	Delete(context.Context, *organization_service.ProviderPK) error
+	ChangeStatus(context.Context, *models.ProviderStatusChange) (int64, error)
+	GetStatusHistory(context.Context, *organization_service.GetProviderStatusHistoryRequest) (*organization_service.GetProviderStatusHistoryResponse, error)
+	Merge(context.Context, *models.ProviderMerge) error
+	GetRedirect(ctx context.Context, oldID string) (string, error)
+}
+
+type ProviderContactRepoI interface {
+	Create(context.Context, *organization_service.AddProviderContactRequest) (*organization_service.ProviderContactPK, error)
+	GetByID(context.Context, *organization_service.ProviderContactPK) (*organization_service.ProviderContact, error)
+	GetList(context.Context, *organization_service.ListProviderContactsRequest) (*organization_service.ListProviderContactsResponse, error)
+	Update(context.Context, *organization_service.UpdateProviderContactRequest) (int64, error)
+	Delete(context.Context, *organization_service.ProviderContactPK) error
+}
+
+type ProviderMagazinRepoI interface {
+	Upsert(context.Context, *organization_service.AssignProviderRequest) error
+	Get(ctx context.Context, providerID, magazinID string) (*organization_service.ProviderAssignment, error)
+	Delete(context.Context, *organization_service.UnassignProviderRequest) (int64, error)
+	GetList(context.Context, *models.ProviderAssignmentFilter) (*organization_service.ListProviderAssignmentsResponse, error)
+}
+
+type ProviderProductRepoI interface {
+	Create(context.Context, *organization_service.AddProviderProductRequest) (*organization_service.ProviderProductPK, error)
+	GetByID(context.Context, *organization_service.ProviderProductPK) (*organization_service.ProviderProduct, error)
+	GetList(context.Context, *organization_service.ListProviderProductsRequest) (*organization_service.ListProviderProductsResponse, error)
+	Update(context.Context, *organization_service.UpdateProviderProductRequest) (int64, error)
+	Delete(context.Context, *organization_service.ProviderProductPK) (int64, error)
+}
+
+type ProviderPerformanceRepoI interface {
+	CreateDelivery(ctx context.Context, req *organization_service.RecordProviderDeliveryRequest, recordedBy string) (*organization_service.ProviderDelivery, error)
+	GetDeliveryList(context.Context, *organization_service.ListProviderDeliveriesRequest) (*organization_service.ListProviderDeliveriesResponse, error)
+	UpsertRating(ctx context.Context, req *organization_service.RateProviderRequest, ratedBy string) (*organization_service.ProviderRating, error)
+	GetRatingList(context.Context, *organization_service.ListProviderRatingsRequest) (*organization_service.ListProviderRatingsResponse, error)
+	GetScorecard(ctx context.Context, providerID string, since time.Time) (*organization_service.ProviderScorecard, error)
+}
+
+type PurchaseOrderRepoI interface {
+	Create(context.Context, *models.PurchaseOrderCreate) (*organization_service.PurchaseOrderPK, error)
+	GetByID(context.Context, *organization_service.PurchaseOrderPK) (*organization_service.PurchaseOrder, error)
+	GetList(context.Context, *organization_service.GetListPurchaseOrderRequest) (*organization_service.GetListPurchaseOrderResponse, error)
+	UpdateDraft(context.Context, *organization_service.UpdatePurchaseOrder) (int64, error)
+	ChangeStatus(context.Context, *models.PurchaseOrderStatusChange) (int64, error)
+	DeleteDraft(context.Context, *organization_service.PurchaseOrderPK) (int64, error)
 }
 
 type StaffRepoI interface {
 	Create(context.Context, *organization_service.CreateStaff) (*organization_service.StaffPK, error)
 	GetByID(context.Context, *organization_service.StaffPK) (*organization_service.Staff, error)
+	GetByPhone(context.Context, *organization_service.StaffPhoneRequest) (*organization_service.Staff, error)
 	GetList(context.Context, *organization_service.GetListStaffRequest) (*organization_service.GetListStaffResponse, error)
 	Update(context.Context, *organization_service.UpdateStaff) (int64, error)
 	UpdatePatch(ctx context.Context, req *models.UpdatePatchRequest) (resp int64, err error)
 	Delete(context.Context, *organization_service.StaffPK) error
+	ChangeStatus(context.Context, *models.StaffStatusChange) (int64, error)
+	GetAuthByLogin(ctx context.Context, login string) (*models.StaffAuth, error)
+	GetAuthByID(ctx context.Context, id string) (*models.StaffAuth, error)
+	GetAuthByPhone(ctx context.Context, phone string) (*models.StaffAuth, error)
+	UpdatePassword(ctx context.Context, id, password string) (int64, error)
+	RegisterLoginFailure(ctx context.Context, id string) (failedLogins, lockouts int, err error)
+	Lock(ctx context.Context, id string, until time.Time) (int64, error)
+	Unlock(ctx context.Context, id string) (int64, error)
+}
+
+type WebhookRepoI interface {
+	Create(context.Context, *organization_service.CreateWebhook) (*organization_service.WebhookPK, error)
+	GetByID(context.Context, *organization_service.WebhookPK) (*organization_service.Webhook, error)
+	GetList(context.Context, *organization_service.GetListWebhookRequest) (*organization_service.GetListWebhookResponse, error)
+	Update(context.Context, *organization_service.UpdateWebhook) (int64, error)
+	Delete(context.Context, *organization_service.WebhookPK) error
+	Enqueue(ctx context.Context, eventType, filialID string, payload []byte) (int64, error)
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	SaveAttempt(context.Context, *models.WebhookDeliveryAttempt) error
+	GetDeliveryByID(context.Context, *organization_service.WebhookDeliveryPK) (*organization_service.WebhookDelivery, error)
+	GetDeliveryList(context.Context, *organization_service.GetListWebhookDeliveryRequest) (*organization_service.GetListWebhookDeliveryResponse, error)
+	ReplayDelivery(context.Context, *organization_service.WebhookDeliveryPK) (int64, error)
+}
+
+type PasswordResetRepoI interface {
+	Create(context.Context, *models.PasswordReset) error
+	GetByID(ctx context.Context, id string) (*models.PasswordReset, error)
+	CountSince(ctx context.Context, phone string, since time.Time) (count int, last time.Time, err error)
+	RecordRequest(ctx context.Context, key string, at time.Time) error
+	CountRequestsSince(ctx context.Context, key string, since time.Time) (count int, last time.Time, err error)
+	IncrementAttempts(ctx context.Context, id string, max int) (int, error)
+	Consume(ctx context.Context, id, staffID, passwordHash string) (int64, error)
+}
+
+type LoginAttemptRepoI interface {
+	Create(context.Context, *models.LoginAttempt) error
+	CountFailuresByIP(ctx context.Context, clientIP string, since time.Time) (int, error)
+}
+
+type ScheduleRepoI interface {
+	ReplaceWeeklyHours(context.Context, *organization_service.SetWeeklyHoursRequest) error
+	GetWeeklyHours(context.Context, *organization_service.GetWeeklyHoursRequest) ([]*organization_service.WorkingHours, error)
+	UpsertException(context.Context, *organization_service.SetScheduleExceptionRequest) (*organization_service.ScheduleException, error)
+	DeleteException(context.Context, *organization_service.ScheduleExceptionPK) (*organization_service.ScheduleException, error)
+	GetExceptionList(context.Context, *organization_service.ListScheduleExceptionsRequest) (*organization_service.ListScheduleExceptionsResponse, error)
 }